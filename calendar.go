@@ -0,0 +1,240 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	errInvalidCalendarDate = errors.New("invalid calendar date")
+	errOutOfCalendarRange  = errors.New("date is outside the range of the calendar")
+)
+
+// CalendarDate is a date in a calendar other than the proleptic Gregorian
+// calendar used by Date. The meaning of the year, month and day depends
+// on the calendar.
+type CalendarDate struct {
+	Era   string // name of the era, for calendars that number years within eras
+	Year  int
+	Month int
+	Day   int
+}
+
+// String returns a string representation of the calendar date in the
+// format yyyy-mm-dd, preceded by the era if there is one.
+func (cd CalendarDate) String() string {
+	year, sign := cd.Year, ""
+	if year < 0 {
+		year, sign = -year, "-"
+	}
+	s := fmt.Sprintf("%s%04d-%02d-%02d", sign, year, cd.Month, cd.Day)
+	if cd.Era != "" {
+		s = cd.Era + " " + s
+	}
+	return s
+}
+
+// Calendar converts dates between the proleptic Gregorian calendar used by
+// Date and another calendar. Implement Calendar to support other calendars.
+type Calendar interface {
+	// Name returns the name of the calendar, such as "hebrew".
+	Name() string
+
+	// FromDate returns the date in the calendar that corresponds to d.
+	// An error is returned if d is outside the range of the calendar.
+	FromDate(d Date) (CalendarDate, error)
+
+	// ToDate returns the Gregorian date that corresponds to cd.
+	// An error is returned if cd is not a valid date in the calendar.
+	ToDate(cd CalendarDate) (Date, error)
+}
+
+// Calendars that are built in.
+var (
+	// JulianCalendar is the proleptic Julian calendar, in which every fourth
+	// year is a leap year. Years are numbered as they are for Date, so year 0
+	// is 1 BC.
+	JulianCalendar Calendar = julianCalendar{}
+
+	// HebrewCalendar is the arithmetic Hebrew calendar. Months are numbered
+	// from Nisan (1) to Adar (12), with Adar II (13) in leap years. The year
+	// starts with Tishrei (7).
+	HebrewCalendar Calendar = hebrewCalendar{}
+
+	// TabularIslamicCalendar is the tabular (arithmetic) Islamic calendar with
+	// the civil epoch of 16 July 622 (Julian) and leap years 2, 5, 7, 10, 13, 16,
+	// 18, 21, 24, 26 and 29 of each 30 year cycle. Dates in calendars based on
+	// observation or astronomical calculation, such as Umm al-Qura, can differ
+	// by a day or two.
+	TabularIslamicCalendar Calendar = tabularIslamicCalendar{}
+
+	// UmmAlQuraCalendar is the Umm al-Qura calendar used in Saudi Arabia,
+	// in which the first day of each month is determined by astronomical
+	// calculation. Conversions use the published tables, so dates before
+	// 1 Muharram 1356 AH (14 March 1937) or after 30 Dhu al-Hijjah 1500 AH
+	// (16 November 2077) are not supported.
+	UmmAlQuraCalendar Calendar = ummAlQuraCalendar{}
+
+	// PersianCalendar is the Solar Hijri calendar used in Iran and Afghanistan,
+	// calculated using Borkowski's algorithm, which agrees with the astronomical
+	// calendar for years -61 to 3177 AP. Dates outside this range are not supported.
+	PersianCalendar Calendar = persianCalendar{}
+
+	// JapaneseCalendar is the Gregorian calendar with years numbered within
+	// the Japanese imperial eras, starting with Meiji in 1868. Era names are
+	// Meiji, Taisho, Showa, Heisei and Reiwa. Dates before 1868 are not supported.
+	JapaneseCalendar Calendar = japaneseCalendar{}
+
+	// ThaiBuddhistCalendar is the Gregorian calendar with years numbered in
+	// the Buddhist Era, which is 543 years ahead of the Gregorian year.
+	ThaiBuddhistCalendar Calendar = thaiBuddhistCalendar{}
+)
+
+// rataDie returns the number of days from 31 December of year 0
+// to d, so that 1 January of year 1 is day 1.
+func rataDie(d Date) int {
+	return d.days + 1
+}
+
+// dateFromRataDie returns the date for a day number returned by rataDie.
+func dateFromRataDie(n int) Date {
+	return Date{days: n - 1}
+}
+
+// julianCalendar implements the proleptic Julian calendar.
+type julianCalendar struct{}
+
+// julianEpoch is the rata die of 1 January of year 1 in the Julian calendar.
+const julianEpoch = -1
+
+func (julianCalendar) Name() string {
+	return "julian"
+}
+
+func (c julianCalendar) FromDate(d Date) (CalendarDate, error) {
+	n := rataDie(d)
+	year := floorDiv(4*(n-julianEpoch)+1464, 1461)
+	prior := n - c.fixed(year, 1, 1)
+	correction := 0
+	if n >= c.fixed(year, 3, 1) {
+		correction = 2
+		if c.isLeap(year) {
+			correction = 1
+		}
+	}
+	month := floorDiv(12*(prior+correction)+373, 367)
+	day := n - c.fixed(year, month, 1) + 1
+	return CalendarDate{Year: year, Month: month, Day: day}, nil
+}
+
+func (c julianCalendar) ToDate(cd CalendarDate) (Date, error) {
+	if cd.Month < 1 || cd.Month > 12 || cd.Day < 1 || cd.Day > c.daysInMonth(cd.Year, cd.Month) {
+		return Date{}, errInvalidCalendarDate
+	}
+	return dateFromRataDie(c.fixed(cd.Year, cd.Month, cd.Day)), nil
+}
+
+func (julianCalendar) isLeap(year int) bool {
+	return floorMod(year, 4) == 0
+}
+
+func (c julianCalendar) daysInMonth(year, month int) int {
+	if month == 2 && c.isLeap(year) {
+		return 29
+	}
+	return daysIn(time.Month(month), 2001)
+}
+
+// fixed returns the rata die of a date in the Julian calendar.
+func (c julianCalendar) fixed(year, month, day int) int {
+	n := julianEpoch - 1 + 365*(year-1) + floorDiv(year-1, 4) + floorDiv(367*month-362, 12) + day
+	if month > 2 {
+		if c.isLeap(year) {
+			n--
+		} else {
+			n -= 2
+		}
+	}
+	return n
+}
+
+// japaneseEra is an era of the Japanese calendar.
+type japaneseEra struct {
+	name  string
+	start Date
+}
+
+// japaneseEras are the eras of the Japanese calendar, in order.
+var japaneseEras = []japaneseEra{
+	{"Meiji", DateFor(1868, time.January, 1)},
+	{"Taisho", DateFor(1912, time.July, 30)},
+	{"Showa", DateFor(1926, time.December, 25)},
+	{"Heisei", DateFor(1989, time.January, 8)},
+	{"Reiwa", DateFor(2019, time.May, 1)},
+}
+
+// japaneseCalendar implements the Japanese imperial era calendar.
+type japaneseCalendar struct{}
+
+func (japaneseCalendar) Name() string {
+	return "japanese"
+}
+
+func (japaneseCalendar) FromDate(d Date) (CalendarDate, error) {
+	for i := len(japaneseEras) - 1; i >= 0; i-- {
+		era := japaneseEras[i]
+		if !d.Before(era.start) {
+			return CalendarDate{
+				Era:   era.name,
+				Year:  d.Year() - era.start.Year() + 1,
+				Month: int(d.Month()),
+				Day:   d.Day(),
+			}, nil
+		}
+	}
+	return CalendarDate{}, errOutOfCalendarRange
+}
+
+func (japaneseCalendar) ToDate(cd CalendarDate) (Date, error) {
+	for i, era := range japaneseEras {
+		if !strings.EqualFold(era.name, cd.Era) {
+			continue
+		}
+		year := era.start.Year() + cd.Year - 1
+		if cd.Year < 1 || !isValidDate(year, cd.Month, cd.Day) {
+			return Date{}, errInvalidCalendarDate
+		}
+		d := DateFor(year, time.Month(cd.Month), cd.Day)
+		if d.Before(era.start) || i+1 < len(japaneseEras) && !d.Before(japaneseEras[i+1].start) {
+			return Date{}, errInvalidCalendarDate
+		}
+		return d, nil
+	}
+	return Date{}, errInvalidCalendarDate
+}
+
+// thaiBuddhistCalendar implements the Thai Buddhist calendar.
+type thaiBuddhistCalendar struct{}
+
+// buddhistEraOffset is the difference between a Buddhist Era year
+// and a Gregorian year.
+const buddhistEraOffset = 543
+
+func (thaiBuddhistCalendar) Name() string {
+	return "buddhist"
+}
+
+func (thaiBuddhistCalendar) FromDate(d Date) (CalendarDate, error) {
+	year, month, day := d.Date()
+	return CalendarDate{Year: year + buddhistEraOffset, Month: int(month), Day: day}, nil
+}
+
+func (thaiBuddhistCalendar) ToDate(cd CalendarDate) (Date, error) {
+	year := cd.Year - buddhistEraOffset
+	if !isValidDate(year, cd.Month, cd.Day) {
+		return Date{}, errInvalidCalendarDate
+	}
+	return DateFor(year, time.Month(cd.Month), cd.Day), nil
+}
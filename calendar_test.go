@@ -0,0 +1,146 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendars(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Calendar Calendar
+		Date     Date
+		Expected CalendarDate
+	}{
+		{JulianCalendar, DateFor(1582, 10, 15), CalendarDate{Year: 1582, Month: 10, Day: 5}},
+		{JulianCalendar, DateFor(2025, 1, 7), CalendarDate{Year: 2024, Month: 12, Day: 25}},
+		{JulianCalendar, DateFor(2100, 3, 14), CalendarDate{Year: 2100, Month: 2, Day: 29}},
+		{JulianCalendar, DateFor(0, 12, 30), CalendarDate{Year: 1, Month: 1, Day: 1}},
+		{HebrewCalendar, DateFor(2025, 9, 23), CalendarDate{Year: 5786, Month: 7, Day: 1}},
+		{HebrewCalendar, DateFor(2024, 10, 3), CalendarDate{Year: 5785, Month: 7, Day: 1}},
+		{HebrewCalendar, DateFor(2025, 4, 13), CalendarDate{Year: 5785, Month: 1, Day: 15}},
+		{HebrewCalendar, DateFor(2024, 3, 24), CalendarDate{Year: 5784, Month: 13, Day: 14}},
+		{HebrewCalendar, DateFor(2025, 12, 15), CalendarDate{Year: 5786, Month: 9, Day: 25}},
+		{TabularIslamicCalendar, DateFor(622, 7, 19), CalendarDate{Year: 1, Month: 1, Day: 1}},
+		{TabularIslamicCalendar, DateFor(2025, 3, 1), CalendarDate{Year: 1446, Month: 9, Day: 1}},
+		{TabularIslamicCalendar, DateFor(2025, 6, 27), CalendarDate{Year: 1447, Month: 1, Day: 1}},
+		{UmmAlQuraCalendar, DateFor(2025, 9, 23), CalendarDate{Year: 1447, Month: 4, Day: 1}},
+		{UmmAlQuraCalendar, DateFor(2025, 3, 1), CalendarDate{Year: 1446, Month: 9, Day: 1}},
+		{UmmAlQuraCalendar, DateFor(2025, 6, 26), CalendarDate{Year: 1447, Month: 1, Day: 1}},
+		{UmmAlQuraCalendar, DateFor(1937, 3, 14), CalendarDate{Year: 1356, Month: 1, Day: 1}},
+		{UmmAlQuraCalendar, DateFor(2077, 11, 16), CalendarDate{Year: 1500, Month: 12, Day: 30}},
+		{PersianCalendar, DateFor(2025, 3, 21), CalendarDate{Year: 1404, Month: 1, Day: 1}},
+		{PersianCalendar, DateFor(2025, 3, 20), CalendarDate{Year: 1403, Month: 12, Day: 30}},
+		{PersianCalendar, DateFor(2025, 9, 30), CalendarDate{Year: 1404, Month: 7, Day: 8}},
+		{PersianCalendar, DateFor(2024, 3, 19), CalendarDate{Year: 1402, Month: 12, Day: 29}},
+		{PersianCalendar, DateFor(1979, 2, 11), CalendarDate{Year: 1357, Month: 11, Day: 22}},
+		{JapaneseCalendar, DateFor(2025, 9, 30), CalendarDate{Era: "Reiwa", Year: 7, Month: 9, Day: 30}},
+		{JapaneseCalendar, DateFor(2019, 4, 30), CalendarDate{Era: "Heisei", Year: 31, Month: 4, Day: 30}},
+		{JapaneseCalendar, DateFor(1989, 1, 7), CalendarDate{Era: "Showa", Year: 64, Month: 1, Day: 7}},
+		{JapaneseCalendar, DateFor(1868, 1, 1), CalendarDate{Era: "Meiji", Year: 1, Month: 1, Day: 1}},
+		{ThaiBuddhistCalendar, DateFor(2025, 9, 30), CalendarDate{Year: 2568, Month: 9, Day: 30}},
+	}
+
+	for _, tc := range testCases {
+		cd, err := tc.Calendar.FromDate(tc.Date)
+		if assert.NoError(err, tc.Date.String()) {
+			assert.Equal(tc.Expected, cd, "%s %s", tc.Calendar.Name(), tc.Date)
+		}
+		d, err := tc.Calendar.ToDate(tc.Expected)
+		if assert.NoError(err, tc.Expected.String()) {
+			assert.True(tc.Date.Equal(d), "%s %s: expected %s, actual %s", tc.Calendar.Name(), tc.Expected, tc.Date, d)
+		}
+	}
+}
+
+func TestCalendarRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+	calendars := []Calendar{
+		JulianCalendar,
+		HebrewCalendar,
+		TabularIslamicCalendar,
+		UmmAlQuraCalendar,
+		PersianCalendar,
+		ThaiBuddhistCalendar,
+	}
+	for _, c := range calendars {
+		for d := DateFor(1990, 1, 1); d.Before(DateFor(2040, 1, 1)); d = d.AddDate(0, 0, 1) {
+			cd, err := c.FromDate(d)
+			if !assert.NoError(err) {
+				break
+			}
+			d1, err := c.ToDate(cd)
+			if !assert.NoError(err, "%s %s", c.Name(), cd) || !assert.True(d.Equal(d1), "%s %s: %s", c.Name(), d, cd) {
+				break
+			}
+		}
+	}
+}
+
+func TestCalendarErrors(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Calendar     Calendar
+		CalendarDate CalendarDate
+	}{
+		{JulianCalendar, CalendarDate{Year: 1900, Month: 2, Day: 30}},
+		{JulianCalendar, CalendarDate{Year: 1900, Month: 13, Day: 1}},
+		{HebrewCalendar, CalendarDate{Year: 5785, Month: 13, Day: 1}},
+		{HebrewCalendar, CalendarDate{Year: 5785, Month: 2, Day: 30}},
+		{TabularIslamicCalendar, CalendarDate{Year: 1446, Month: 2, Day: 30}},
+		{UmmAlQuraCalendar, CalendarDate{Year: 1447, Month: 2, Day: 30}},
+		{UmmAlQuraCalendar, CalendarDate{Year: 1447, Month: 13, Day: 1}},
+		{UmmAlQuraCalendar, CalendarDate{Year: 1355, Month: 12, Day: 1}},
+		{UmmAlQuraCalendar, CalendarDate{Year: 1501, Month: 1, Day: 1}},
+		{PersianCalendar, CalendarDate{Year: 1404, Month: 12, Day: 30}},
+		{PersianCalendar, CalendarDate{Year: 4000, Month: 1, Day: 1}},
+		{JapaneseCalendar, CalendarDate{Era: "Heisei", Year: 31, Month: 5, Day: 1}},
+		{JapaneseCalendar, CalendarDate{Era: "Edo", Year: 1, Month: 1, Day: 1}},
+		{ThaiBuddhistCalendar, CalendarDate{Year: 2568, Month: 2, Day: 29}},
+	}
+
+	for _, tc := range testCases {
+		_, err := tc.Calendar.ToDate(tc.CalendarDate)
+		assert.Error(err, "%s %s", tc.Calendar.Name(), tc.CalendarDate)
+	}
+
+	_, err := JapaneseCalendar.FromDate(DateFor(1867, 12, 31))
+	assert.Error(err)
+	_, err = PersianCalendar.FromDate(DateFor(3800, 1, 1))
+	assert.Error(err)
+}
+
+func TestUmmAlQuraRange(t *testing.T) {
+	assert := assert.New(t)
+	first := CalendarDate{Year: 1356, Month: 1, Day: 1}
+	last := CalendarDate{Year: 1500, Month: 12, Day: 30}
+
+	cd, err := UmmAlQuraCalendar.FromDate(DateFor(1937, 3, 14))
+	assert.NoError(err)
+	assert.Equal(first, cd)
+	cd, err = UmmAlQuraCalendar.FromDate(DateFor(2077, 11, 16))
+	assert.NoError(err)
+	assert.Equal(last, cd)
+	d, err := UmmAlQuraCalendar.ToDate(first)
+	assert.NoError(err)
+	assert.Equal(DateFor(1937, 3, 14), d)
+	d, err = UmmAlQuraCalendar.ToDate(last)
+	assert.NoError(err)
+	assert.Equal(DateFor(2077, 11, 16), d)
+
+	_, err = UmmAlQuraCalendar.FromDate(DateFor(1937, 3, 13))
+	assert.Error(err)
+	_, err = UmmAlQuraCalendar.FromDate(DateFor(2077, 11, 17))
+	assert.Error(err)
+	_, err = UmmAlQuraCalendar.ToDate(CalendarDate{Year: 1500, Month: 12, Day: 31})
+	assert.Error(err)
+	_, err = UmmAlQuraCalendar.ToDate(CalendarDate{Year: 1355, Month: 12, Day: 29})
+	assert.Error(err)
+}
+
+func TestCalendarDateString(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("Reiwa 0007-09-30", CalendarDate{Era: "Reiwa", Year: 7, Month: 9, Day: 30}.String())
+	assert.Equal("-0044-03-15", CalendarDate{Year: -44, Month: 3, Day: 15}.String())
+}
@@ -0,0 +1,186 @@
+package local
+
+import "sort"
+
+// Compare compares d and e. The result is -1 if d is before e,
+// 0 if d equals e and +1 if d is after e. Compare can be used with
+// functions such as slices.SortFunc.
+func (d Date) Compare(e Date) int {
+	switch {
+	case d.days < e.days:
+		return -1
+	case d.days > e.days:
+		return +1
+	}
+	return 0
+}
+
+// Compare compares dt and e. The result is -1 if dt is before e,
+// 0 if dt equals e and +1 if dt is after e. Compare can be used with
+// functions such as slices.SortFunc.
+func (dt DateTime) Compare(e DateTime) int {
+	switch {
+	case dt.Before(e):
+		return -1
+	case dt.After(e):
+		return +1
+	}
+	return 0
+}
+
+// MinDate returns the earliest of the dates.
+func MinDate(d Date, others ...Date) Date {
+	for _, e := range others {
+		if e.Before(d) {
+			d = e
+		}
+	}
+	return d
+}
+
+// MaxDate returns the latest of the dates.
+func MaxDate(d Date, others ...Date) Date {
+	for _, e := range others {
+		if e.After(d) {
+			d = e
+		}
+	}
+	return d
+}
+
+// ClampDate returns d limited to the range [lo, hi]. If hi is before lo,
+// the result is lo.
+func ClampDate(d, lo, hi Date) Date {
+	return MaxDate(lo, MinDate(d, hi))
+}
+
+// MinDateTime returns the earliest of the date-times.
+func MinDateTime(dt DateTime, others ...DateTime) DateTime {
+	for _, e := range others {
+		if e.Before(dt) {
+			dt = e
+		}
+	}
+	return dt
+}
+
+// MaxDateTime returns the latest of the date-times.
+func MaxDateTime(dt DateTime, others ...DateTime) DateTime {
+	for _, e := range others {
+		if e.After(dt) {
+			dt = e
+		}
+	}
+	return dt
+}
+
+// ClampDateTime returns dt limited to the range [lo, hi]. If hi is before lo,
+// the result is lo.
+func ClampDateTime(dt, lo, hi DateTime) DateTime {
+	return MaxDateTime(lo, MinDateTime(dt, hi))
+}
+
+// NullOrder specifies where null values are placed when sorting
+// NullDate and NullDateTime values.
+type NullOrder int
+
+const (
+	// NullsFirst sorts null values before all other values.
+	NullsFirst NullOrder = iota
+
+	// NullsLast sorts null values after all other values.
+	NullsLast
+)
+
+// compareNull compares the validity of two nullable values. The result is
+// non-zero if exactly one of them is null, in which case it orders the
+// values according to order.
+func (order NullOrder) compareNull(aValid, bValid bool) int {
+	if aValid == bValid {
+		return 0
+	}
+	c := -1
+	if aValid {
+		c = +1
+	}
+	if order == NullsLast {
+		c = -c
+	}
+	return c
+}
+
+// CompareNullDates compares a and b, placing null values according to order.
+// Two null values are equal.
+func CompareNullDates(a, b NullDate, order NullOrder) int {
+	if c := order.compareNull(a.Valid, b.Valid); c != 0 || !a.Valid {
+		return c
+	}
+	return a.Date.Compare(b.Date)
+}
+
+// CompareNullDateTimes compares a and b, placing null values according to order.
+// Two null values are equal.
+func CompareNullDateTimes(a, b NullDateTime, order NullOrder) int {
+	if c := order.compareNull(a.Valid, b.Valid); c != 0 || !a.Valid {
+		return c
+	}
+	return a.DateTime.Compare(b.DateTime)
+}
+
+// SortDates sorts a slice of dates in increasing order.
+func SortDates(dates []Date) {
+	sort.Sort(dateSlice(dates))
+}
+
+// SortDateTimes sorts a slice of date-times in increasing order.
+func SortDateTimes(dateTimes []DateTime) {
+	sort.Sort(dateTimeSlice(dateTimes))
+}
+
+// SortNullDates sorts a slice of nullable dates in increasing order,
+// placing null values according to order.
+func SortNullDates(dates []NullDate, order NullOrder) {
+	sort.Sort(nullDateSlice{dates: dates, order: order})
+}
+
+// SortNullDateTimes sorts a slice of nullable date-times in increasing order,
+// placing null values according to order.
+func SortNullDateTimes(dateTimes []NullDateTime, order NullOrder) {
+	sort.Sort(nullDateTimeSlice{dateTimes: dateTimes, order: order})
+}
+
+type dateSlice []Date
+
+func (s dateSlice) Len() int           { return len(s) }
+func (s dateSlice) Less(i, j int) bool { return s[i].Before(s[j]) }
+func (s dateSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type dateTimeSlice []DateTime
+
+func (s dateTimeSlice) Len() int           { return len(s) }
+func (s dateTimeSlice) Less(i, j int) bool { return s[i].Before(s[j]) }
+func (s dateTimeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type nullDateSlice struct {
+	dates []NullDate
+	order NullOrder
+}
+
+func (s nullDateSlice) Len() int { return len(s.dates) }
+func (s nullDateSlice) Less(i, j int) bool {
+	return CompareNullDates(s.dates[i], s.dates[j], s.order) < 0
+}
+func (s nullDateSlice) Swap(i, j int) { s.dates[i], s.dates[j] = s.dates[j], s.dates[i] }
+
+type nullDateTimeSlice struct {
+	dateTimes []NullDateTime
+	order     NullOrder
+}
+
+func (s nullDateTimeSlice) Len() int { return len(s.dateTimes) }
+func (s nullDateTimeSlice) Less(i, j int) bool {
+	return CompareNullDateTimes(s.dateTimes[i], s.dateTimes[j], s.order) < 0
+}
+func (s nullDateTimeSlice) Swap(i, j int) {
+	s.dateTimes[i], s.dateTimes[j] = s.dateTimes[j], s.dateTimes[i]
+}
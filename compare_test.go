@@ -0,0 +1,85 @@
+package local
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateCompare(t *testing.T) {
+	assert := assert.New(t)
+	d1 := DateFor(2025, time.September, 30)
+	d2 := DateFor(2025, time.October, 1)
+	assert.Equal(-1, d1.Compare(d2))
+	assert.Equal(0, d1.Compare(d1))
+	assert.Equal(+1, d2.Compare(d1))
+
+	assert.Equal(d1, MinDate(d2, d1, d2))
+	assert.Equal(d2, MaxDate(d1, d2, d1))
+	assert.Equal(d1, MinDate(d1))
+
+	lo := DateFor(2025, time.January, 1)
+	hi := DateFor(2025, time.December, 31)
+	assert.Equal(lo, ClampDate(DateFor(2024, time.June, 1), lo, hi))
+	assert.Equal(hi, ClampDate(DateFor(2026, time.June, 1), lo, hi))
+	assert.Equal(d1, ClampDate(d1, lo, hi))
+	assert.Equal(hi, ClampDate(d1, hi, lo))
+}
+
+func TestDateTimeCompare(t *testing.T) {
+	assert := assert.New(t)
+	dt1 := DateTimeForNano(2025, time.September, 30, 15, 4, 5, 1)
+	dt2 := DateTimeForNano(2025, time.September, 30, 15, 4, 5, 2)
+	assert.Equal(-1, dt1.Compare(dt2))
+	assert.Equal(0, dt1.Compare(dt1))
+	assert.Equal(+1, dt2.Compare(dt1))
+
+	assert.Equal(dt1, MinDateTime(dt2, dt1, dt2))
+	assert.Equal(dt2, MaxDateTime(dt1, dt2, dt1))
+
+	lo := DateTimeFor(2025, time.January, 1, 0, 0, 0)
+	hi := DateTimeFor(2025, time.December, 31, 0, 0, 0)
+	assert.Equal(lo, ClampDateTime(DateTimeFor(2024, time.June, 1, 0, 0, 0), lo, hi))
+	assert.Equal(hi, ClampDateTime(DateTimeFor(2026, time.June, 1, 0, 0, 0), lo, hi))
+	assert.Equal(dt1, ClampDateTime(dt1, lo, hi))
+}
+
+func TestSortDates(t *testing.T) {
+	assert := assert.New(t)
+	d1 := DateFor(2025, time.January, 1)
+	d2 := DateFor(2025, time.February, 1)
+	d3 := DateFor(2025, time.March, 1)
+
+	dates := []Date{d3, d1, d2}
+	SortDates(dates)
+	assert.Equal([]Date{d1, d2, d3}, dates)
+
+	null := NullDate{}
+	n1, n2 := NullDate{Date: d1, Valid: true}, NullDate{Date: d2, Valid: true}
+	nullDates := []NullDate{n2, null, n1}
+	SortNullDates(nullDates, NullsFirst)
+	assert.Equal([]NullDate{null, n1, n2}, nullDates)
+	SortNullDates(nullDates, NullsLast)
+	assert.Equal([]NullDate{n1, n2, null}, nullDates)
+	assert.Equal(0, CompareNullDates(null, null, NullsLast))
+}
+
+func TestSortDateTimes(t *testing.T) {
+	assert := assert.New(t)
+	dt1 := DateTimeFor(2025, time.January, 1, 12, 0, 0)
+	dt2 := DateTimeFor(2025, time.January, 1, 13, 0, 0)
+	dt3 := DateTimeFor(2025, time.January, 2, 0, 0, 0)
+
+	dateTimes := []DateTime{dt3, dt1, dt2}
+	SortDateTimes(dateTimes)
+	assert.Equal([]DateTime{dt1, dt2, dt3}, dateTimes)
+
+	null := NullDateTime{}
+	n1, n2 := NullDateTime{DateTime: dt1, Valid: true}, NullDateTime{DateTime: dt2, Valid: true}
+	nullDateTimes := []NullDateTime{n2, null, n1}
+	SortNullDateTimes(nullDateTimes, NullsFirst)
+	assert.Equal([]NullDateTime{null, n1, n2}, nullDateTimes)
+	SortNullDateTimes(nullDateTimes, NullsLast)
+	assert.Equal([]NullDateTime{n1, n2, null}, nullDateTimes)
+}
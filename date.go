@@ -2,71 +2,100 @@ package local
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 )
 
 // Date represents a date without a time or a timezone.
 // Useful for representing date of birth, for example.
 //
-// Calculations on Date are performed using the standard
-// library's time.Time type. For these calculations the time is
-// midnight and the timezone is UTC.
+// A Date is stored as the number of days since January 1, year 1
+// in the proleptic Gregorian calendar, so Date values can be compared
+// using == and used as map keys.
 type Date struct {
-	t time.Time
+	days int // days since January 1, year 1
 }
 
+// unixEpochDays is the number of days from January 1, year 1
+// to January 1, 1970.
+const unixEpochDays = 719162
+
 // After reports whether the local date d is after e.
 func (d Date) After(e Date) bool {
-	return d.t.After(e.t)
+	return d.days > e.days
 }
 
 // Before reports whether the local date d is before e.
 func (d Date) Before(e Date) bool {
-	return d.t.Before(e.t)
+	return d.days < e.days
 }
 
 // Equal reports whether d and e represent the same local date.
+// Equal is equivalent to d == e.
 func (d Date) Equal(e Date) bool {
-	return d.t.Equal(e.t)
+	return d.days == e.days
 }
 
 // IsZero reports whether d represents the zero local date,
 // January 1, year 1.
 func (d Date) IsZero() bool {
-	return d.t.IsZero()
+	return d.days == 0
 }
 
 // Date returns the year, month and day on which d occurs.
 func (d Date) Date() (year int, month time.Month, day int) {
-	return d.t.Date()
+	return civilFromDays(d.Days())
 }
 
 // Unix returns d as a Unix time, the number of seconds elapsed
 // since January 1, 1970 UTC to midnight of the date UTC.
 func (d Date) Unix() int64 {
-	return d.t.Unix()
+	return int64(d.Days()) * secondsPerDay
+}
+
+// Days returns the number of days elapsed since January 1, 1970
+// to d. Dates before 1970 have a negative number of days.
+func (d Date) Days() int {
+	return d.days - unixEpochDays
+}
+
+// UnixDays returns the number of days elapsed since January 1, 1970
+// to d as an int32, which is how dates are stored by MarshalBinary and
+// by formats such as Avro and Parquet. The result is false if the number
+// of days does not fit in an int32.
+func (d Date) UnixDays() (int32, bool) {
+	days := d.Days()
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(days), true
 }
 
 // Year returns the year in which d occurs.
 func (d Date) Year() int {
-	return d.t.Year()
+	year, _, _ := d.Date()
+	return year
 }
 
 // Month returns the month of the year specified by d.
 func (d Date) Month() time.Month {
-	return d.t.Month()
+	_, month, _ := d.Date()
+	return month
 }
 
 // Day returns the day of the month specified by d.
 func (d Date) Day() int {
-	return d.t.Day()
+	_, _, day := d.Date()
+	return day
 }
 
 // Weekday returns the day of the week specified by d.
 func (d Date) Weekday() time.Weekday {
-	return d.t.Weekday()
+	// January 1, year 1 was a Monday
+	return time.Weekday((d.days%7+7)%7+1) % 7
 }
 
 // ISOWeek returns the ISO 8601 year and week number in which d occurs.
@@ -74,20 +103,31 @@ func (d Date) Weekday() time.Weekday {
 // week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
 // of year n+1.
 func (d Date) ISOWeek() (year, week int) {
-	year, week = d.t.ISOWeek()
-	return
+	// weeks belong to the year that contains their Thursday
+	thursday := Date{days: d.days - (int(d.Weekday())+6)%7 + 3}
+	return thursday.Year(), (thursday.YearDay()-1)/7 + 1
 }
 
 // YearDay returns the day of the year specified by D, in the range [1,365] for non-leap years,
 // and [1,366] in leap years.
 func (d Date) YearDay() int {
-	return d.t.YearDay()
+	return d.days - DateFor(d.Year(), time.January, 1).days + 1
 }
 
-// Add returns the local date d + duration.
+// Quarter returns the calendar quarter in which d occurs, in the range [1,4].
+func (d Date) Quarter() int {
+	return (int(d.Month())-1)/3 + 1
+}
+
+// Half returns the half of the calendar year in which d occurs, in the range [1,2].
+func (d Date) Half() int {
+	return (int(d.Month())-1)/6 + 1
+}
+
+// Add returns the local date d + duration. The duration is
+// truncated to a whole number of days.
 func (d Date) Add(duration time.Duration) Date {
-	t := d.t.Add(toDays(duration))
-	return Date{t: t}
+	return Date{days: d.days + toDays(duration)}
 }
 
 // Sub returns the duration d-e, which will be an integral number of days.
@@ -95,7 +135,14 @@ func (d Date) Add(duration time.Duration) Date {
 // in a Duration, the maximum (or minimum) duration will be returned.
 // To compute d-duration, use d.Add(-duration).
 func (d Date) Sub(e Date) time.Duration {
-	return d.t.Sub(e.t)
+	days := d.days - e.days
+	switch {
+	case days > math.MaxInt64/nanosecondsPerDay:
+		return time.Duration(math.MaxInt64)
+	case days < math.MinInt64/nanosecondsPerDay:
+		return time.Duration(math.MinInt64)
+	}
+	return time.Duration(days) * nanosecondsPerDay
 }
 
 // AddDate returns the local date corresponding to adding the given number of years,
@@ -105,8 +152,8 @@ func (d Date) Sub(e Date) time.Duration {
 // AddDate normalizes its result in the same way that Date does, so, for example,
 // adding one month to October 31 yields December 1, the normalized form for November 31.
 func (d Date) AddDate(years int, months int, days int) Date {
-	t := d.t.AddDate(years, months, days)
-	return Date{t: t}
+	year, month, day := d.Date()
+	return DateFor(year+years, month+time.Month(months), day+days)
 }
 
 // toDate converts the time.Time value into a Date.,
@@ -126,9 +173,56 @@ func Today() Date {
 // and will be normalized during the conversion.
 // For example, October 32 converts to November 1.
 func DateFor(year int, month time.Month, day int) Date {
-	return Date{
-		t: time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+	m := int(month) - 1
+	year += floorDiv(m, 12)
+	month = time.Month(floorMod(m, 12) + 1)
+	return DateFromDays(daysFromCivil(year, month, 1) + day - 1)
+}
+
+// DateFromDays returns the Date that is the given number of days
+// after January 1, 1970. It is the inverse of Date.Days.
+func DateFromDays(days int) Date {
+	return Date{days: days + unixEpochDays}
+}
+
+// DateFromUnixDays returns the Date that is the given number of days
+// after January 1, 1970. It is the inverse of Date.UnixDays.
+func DateFromUnixDays(days int32) Date {
+	return DateFromDays(int(days))
+}
+
+// daysFromCivil returns the number of days from January 1, 1970 to
+// a date in the proleptic Gregorian calendar. The month must be in
+// the range [1,12]. The algorithm is from Howard Hinnant,
+// "chrono-Compatible Low-Level Date Algorithms".
+func daysFromCivil(year int, month time.Month, day int) int {
+	if month <= time.February {
+		year--
+	}
+	era := floorDiv(year, 400)
+	yearOfEra := year - era*400
+	dayOfYear := (153*((int(month)+9)%12)+2)/5 + day - 1 // from March 1
+	dayOfEra := yearOfEra*365 + yearOfEra/4 - yearOfEra/100 + dayOfYear
+	return era*146097 + dayOfEra - 719468
+}
+
+// civilFromDays returns the date in the proleptic Gregorian calendar that
+// is the given number of days after January 1, 1970. It is the inverse
+// of daysFromCivil.
+func civilFromDays(days int) (year int, month time.Month, day int) {
+	days += 719468
+	era := floorDiv(days, 146097)
+	dayOfEra := days - era*146097
+	yearOfEra := (dayOfEra - dayOfEra/1460 + dayOfEra/36524 - dayOfEra/146096) / 365
+	dayOfYear := dayOfEra - (365*yearOfEra + yearOfEra/4 - yearOfEra/100) // from March 1
+	mp := (5*dayOfYear + 2) / 153
+	day = dayOfYear - (153*mp+2)/5 + 1
+	month = time.Month((mp+2)%12 + 1)
+	year = yearOfEra + era*400
+	if month <= time.February {
+		year++
 	}
+	return year, month, day
 }
 
 // DateFromTime returns the Date corresponding to t.
@@ -142,7 +236,8 @@ func DateFromTime(t time.Time) Date {
 // time package. Note that with a Date the reference time is
 //  Mon Jan 2 2006
 func (d Date) Format(layout string) string {
-	return d.t.Format(layout)
+	year, month, day := d.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format(layout)
 }
 
 // String returns a string representation of d. The date
@@ -167,13 +262,37 @@ func toQuotedDateString(d Date) string {
 	return fmt.Sprintf(`"%s"`, toDateString(d))
 }
 
+// binaryVersionCompact is the first byte of the compact binary encoding
+// of Date and DateTime. It distinguishes the compact encoding from the
+// encoding of a time.Time, which starts with a version number of 1 or 2.
+const binaryVersionCompact byte = 0x10
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is five bytes: a version number, followed by the number of
+// days since January 1, 1970 as a big-endian int32.
 func (d Date) MarshalBinary() ([]byte, error) {
-	return d.t.MarshalBinary()
+	days, ok := d.UnixDays()
+	if !ok {
+		return nil, errors.New("local.Date.MarshalBinary: date out of range")
+	}
+	data := make([]byte, 5)
+	data[0] = binaryVersionCompact
+	binary.BigEndian.PutUint32(data[1:], uint32(days))
+	return data, nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// In addition to the encoding produced by MarshalBinary, the encoding of
+// a time.Time is accepted, which is the encoding produced by earlier
+// versions of this package.
 func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) > 0 && data[0] == binaryVersionCompact {
+		if len(data) != 5 {
+			return errors.New("local.Date.UnmarshalBinary: invalid length")
+		}
+		*d = DateFromUnixDays(int32(binary.BigEndian.Uint32(data[1:])))
+		return nil
+	}
 	var t time.Time
 	if err := t.UnmarshalBinary(data); err != nil {
 		return err
@@ -190,8 +309,17 @@ func (d Date) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // The date is expected to be a quoted string in an ISO 8601
-// format (calendar or ordinal).
+// format (calendar or ordinal). If StrictJSON is set, the date
+// must be a quoted RFC 3339 full-date.
 func (d *Date) UnmarshalJSON(data []byte) (err error) {
+	if StrictJSON {
+		s, ok := unquoteJSON(data)
+		if !ok {
+			return errInvalidRFC3339Date
+		}
+		*d, err = DateParseRFC3339(s)
+		return
+	}
 	s := string(data)
 	*d, err = DateParse(s)
 	return
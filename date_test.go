@@ -3,6 +3,8 @@ package local
 import (
 	"encoding/xml"
 	"fmt"
+	"math"
+	"strconv"
 	"testing"
 	"time"
 
@@ -146,13 +148,18 @@ func CheckLocalDate(t *testing.T, date Date, year, month, day int) {
 	if err != nil {
 		t.Errorf("MarshalBinary: %s: unexpected error: %v", text, err)
 	} else {
-		// binary should be the same as the equivalent time binary
-		tdata, _ := date.t.MarshalBinary()
-		assert.Equal(tdata, data)
+		assert.Len(data, 5)
 		var date2 Date
 		err = date2.UnmarshalBinary(data)
 		assert.NoError(err, date.String())
+		assert.True(date.Equal(date2), date.String())
 	}
+
+	// unmarshal the time binary produced by earlier versions
+	tdata, _ := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).MarshalBinary()
+	var date3 Date
+	assert.NoError(date3.UnmarshalBinary(tdata), date.String())
+	assert.True(date.Equal(date3), date.String())
 }
 
 func TestParseDate(t *testing.T) {
@@ -435,6 +442,43 @@ func TestDateUnmarshalBinaryError(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestDateDays(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Date Date
+		Days int
+	}{
+		{DateFor(1970, 1, 1), 0},
+		{DateFor(1970, 1, 2), 1},
+		{DateFor(1969, 12, 31), -1},
+		{DateFor(2025, 9, 30), 20361},
+		{DateFor(1, 1, 1), -719162},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Days, tc.Date.Days(), tc.Date.String())
+		assert.True(tc.Date.Equal(DateFromDays(tc.Days)), tc.Date.String())
+		days, ok := tc.Date.UnixDays()
+		assert.True(ok, tc.Date.String())
+		assert.Equal(int32(tc.Days), days, tc.Date.String())
+		assert.True(tc.Date.Equal(DateFromUnixDays(days)), tc.Date.String())
+	}
+
+	if strconv.IntSize > 32 {
+		maxDays := math.MaxInt32
+		_, ok := DateFromDays(maxDays + 1).UnixDays()
+		assert.False(ok)
+		_, ok = DateFromDays(-maxDays - 2).UnixDays()
+		assert.False(ok)
+	}
+
+	data, err := DateFor(2025, 9, 30).MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{0x10, 0, 0, 0x4f, 0x89}, data)
+	var d Date
+	assert.Error(d.UnmarshalBinary([]byte{0x10, 0, 0, 0x4f}))
+}
+
 func TestDateAddDate(t *testing.T) {
 	assert := assert.New(t)
 	testCases := []struct {
@@ -546,6 +590,146 @@ func TestDateParseLayout(t *testing.T) {
 	}
 }
 
+func TestDateParseOffset(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Expected Date
+		Offset   int
+		HasZone  bool
+		Error    bool
+	}{
+		{
+			Text:     "2025-09-30",
+			Expected: DateFor(2025, 9, 30),
+		},
+		{
+			Text:     "2025-09-30T23:00:00",
+			Expected: DateFor(2025, 9, 30),
+		},
+		{
+			Text:     "2025-09-30T23:00:00-05:00",
+			Expected: DateFor(2025, 9, 30),
+			Offset:   -5 * 3600,
+			HasZone:  true,
+		},
+		{
+			Text:     "2195-060T030211Z",
+			Expected: DateFor(2195, 3, 1),
+			HasZone:  true,
+		},
+		{
+			Text:  "2025-09-30T23:00:00+24:00",
+			Error: true,
+		},
+		{
+			Text:  "30/09/2025",
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		d, loc, err := DateParseOffset(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+			continue
+		}
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Expected, d, datesNotEqual(tc.Expected, d))
+		if !tc.HasZone {
+			assert.Nil(loc, tc.Text)
+			continue
+		}
+		if assert.NotNil(loc, tc.Text) {
+			_, offset := time.Date(2025, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(tc.Offset, offset, tc.Text)
+		}
+	}
+}
+
+func TestDateParseIn(t *testing.T) {
+	assert := assert.New(t)
+	brisbane := time.FixedZone("Australia/Brisbane", 10*3600)
+	testCases := []struct {
+		Text     string
+		Expected Date
+		Error    bool
+	}{
+		{
+			Text:     "2025-09-30",
+			Expected: DateFor(2025, 9, 30),
+		},
+		{
+			Text:     "2025-09-30T23:00:00",
+			Expected: DateFor(2025, 9, 30),
+		},
+		{
+			Text:     "2025-09-30T23:00:00-05:00",
+			Expected: DateFor(2025, 10, 1),
+		},
+		{
+			Text:     "2025-09-30T13:59:59Z",
+			Expected: DateFor(2025, 9, 30),
+		},
+		{
+			Text:     "2025-09-30T14:00:00Z",
+			Expected: DateFor(2025, 10, 1),
+		},
+		{
+			Text:  "2025-09-30Z",
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		d, err := DateParseIn(tc.Text, brisbane)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+			continue
+		}
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Expected, d, datesNotEqual(tc.Expected, d))
+	}
+}
+
 func datesNotEqual(expected, actual Date) string {
 	return fmt.Sprintf("%s vs %s", expected.String(), actual.String())
 }
+
+func TestDateComparable(t *testing.T) {
+	assert := assert.New(t)
+	d1 := DateFor(2025, time.September, 30)
+	d2 := DateFromTime(time.Date(2025, 9, 30, 23, 59, 0, 0, time.FixedZone("AEST", 10*3600)))
+	var d3 Date
+	data, err := d1.MarshalBinary()
+	assert.NoError(err)
+	assert.NoError(d3.UnmarshalBinary(data))
+	assert.True(d1 == d2)
+	assert.True(d1 == d3)
+	assert.True(DateFor(2025, time.October, 0) == d1)
+	assert.True(Date{} == DateFor(1, time.January, 1))
+
+	m := map[Date]int{d1: 1}
+	m[d2]++
+	m[d3]++
+	assert.Equal(map[Date]int{d1: 3}, m)
+}
+
+func TestDateMatchesTime(t *testing.T) {
+	assert := assert.New(t)
+	for days := -800000; days <= 800000; days += 997 {
+		tm := time.Unix(int64(days)*secondsPerDay, 0).UTC()
+		d := DateFromDays(days)
+		year, month, day := d.Date()
+		assert.Equal(tm.Year(), year)
+		assert.Equal(tm.Month(), month)
+		assert.Equal(tm.Day(), day)
+		assert.Equal(tm.Weekday(), d.Weekday())
+		assert.Equal(tm.YearDay(), d.YearDay())
+		tyear, tweek := tm.ISOWeek()
+		dyear, dweek := d.ISOWeek()
+		assert.Equal(tyear, dyear)
+		assert.Equal(tweek, dweek)
+		assert.Equal(d, DateFor(year, month, day))
+	}
+}
@@ -2,15 +2,17 @@ package local
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
 // DateTime represents a date-time without a timezone.
-// Calculations on DateTime are performed using the standard
-// library's time.Time type. For these calculations the
-// timezone is UTC.
+// A DateTime is stored as the number of seconds since midnight,
+// January 1, year 1 and a nanosecond offset within the second,
+// so DateTime values can be compared using == and used as map keys.
 //
 // DateTime is useful in situations where a date and time
 // are specified, without reference to a timezone. Although not
@@ -19,51 +21,74 @@ import (
 // of the timezone that the patient is residing in at the time.
 //
 // Because DateTime does not specify a unique instant in
-// time, it has never been necessary to specify to sub-second
-// accuracy. For this reason DateTime only specifies the
-// time to second accuracy. In actual fact, DateTime would
-// probably be fine if it only specified to minute accuracy.
+// time, it is not often necessary to specify to sub-second
+// accuracy. For this reason DateTime values created from a
+// time.Time or parsed from text are, by default, kept to
+// second accuracy. Set DateTimePrecision to keep fractional
+// seconds, for example when exchanging values with a database
+// that stores milliseconds.
 type DateTime struct {
-	t time.Time
+	sec  int64 // seconds since midnight, January 1, year 1
+	nsec int32 // nanoseconds within the second, in the range [0, 999999999]
 }
 
+// unixEpochSeconds is the number of seconds from midnight, January 1, year 1
+// to midnight, January 1, 1970.
+const unixEpochSeconds = unixEpochDays * secondsPerDay
+
+// DateTimePrecision is the precision to which DateTime values are kept
+// when they are created from a time.Time, parsed from text, or adjusted
+// using Add. Any part of a second smaller than DateTimePrecision is
+// truncated. The default is time.Second, so fractional seconds are discarded.
+// A value of time.Nanosecond keeps all of the precision available.
+//
+// DateTimePrecision is intended to be set once during program initialization.
+var DateTimePrecision = time.Second
+
 // After reports whether the local date-time d is after e
 func (dt DateTime) After(e DateTime) bool {
-	return dt.t.After(e.t)
+	return dt.sec > e.sec || dt.sec == e.sec && dt.nsec > e.nsec
 }
 
 // Before reports whether the local date-time d is before e
 func (dt DateTime) Before(e DateTime) bool {
-	return dt.t.Before(e.t)
+	return dt.sec < e.sec || dt.sec == e.sec && dt.nsec < e.nsec
 }
 
 // Equal reports whether dt and e represent the same local date-time.
+// Equal is equivalent to dt == e.
 func (dt DateTime) Equal(e DateTime) bool {
-	return dt.t.Equal(e.t)
+	return dt == e
 }
 
 // IsZero reports whether dt represents the zero local date-time,
 // Midnight, January 1, year 1.
 func (dt DateTime) IsZero() bool {
-	return dt.t.IsZero()
+	return dt.sec == 0 && dt.nsec == 0
 }
 
 // Date returns the year, month and day on which dt occurs.
 func (dt DateTime) Date() (year int, month time.Month, day int) {
-	return dt.t.Date()
+	return dt.date().Date()
+}
+
+// date returns the local date on which dt occurs.
+func (dt DateTime) date() Date {
+	return Date{days: int(floorDiv64(dt.sec, secondsPerDay))}
 }
 
 // Clock returns the hour, minute and second on which dt occurs.
 func (dt DateTime) Clock() (hour int, minute int, second int) {
-	hour = dt.Hour()
-	minute = dt.Minute()
-	second = dt.Second()
+	seconds := int(dt.sec - int64(dt.date().days)*secondsPerDay)
+	hour = seconds / 3600
+	minute = seconds / 60 % 60
+	second = seconds % 60
 	return
 }
 
 // DateTime returns the year, month, day, hour minute, second and nanosecond on which dt occurs.
 func (dt DateTime) DateTime() (year int, month time.Month, day int, hour int, minute int, second int) {
-	year, month, day = dt.t.Date()
+	year, month, day = dt.Date()
 	hour, minute, second = dt.Clock()
 	return
 }
@@ -71,42 +96,75 @@ func (dt DateTime) DateTime() (year int, month time.Month, day int, hour int, mi
 // Unix returns d as a Unix time, the number of seconds elapsed
 // since January 1, 1970 UTC to midnight of the date-time UTC.
 func (dt DateTime) Unix() int64 {
-	return dt.t.Unix()
+	return dt.sec - unixEpochSeconds
+}
+
+// UnixMilli returns dt as the number of milliseconds elapsed since
+// January 1, 1970 UTC to the date-time UTC. The result is undefined if
+// it cannot be represented by an int64 (a date more than 292 million
+// years from 1970).
+func (dt DateTime) UnixMilli() int64 {
+	return dt.Unix()*1e3 + int64(dt.nsec)/1e6
+}
+
+// UnixMicro returns dt as the number of microseconds elapsed since
+// January 1, 1970 UTC to the date-time UTC. The result is undefined if
+// it cannot be represented by an int64 (a date more than 292 thousand
+// years from 1970).
+func (dt DateTime) UnixMicro() int64 {
+	return dt.Unix()*1e6 + int64(dt.nsec)/1e3
+}
+
+// UnixNano returns dt as the number of nanoseconds elapsed since
+// January 1, 1970 UTC to the date-time UTC. The result is undefined if
+// it cannot be represented by an int64 (a date before the year 1678 or
+// after 2262).
+func (dt DateTime) UnixNano() int64 {
+	return dt.Unix()*1e9 + int64(dt.nsec)
 }
 
 // Year returns the year in which dt occurs.
 func (dt DateTime) Year() int {
-	return dt.t.Year()
+	return dt.date().Year()
 }
 
 // Month returns the month of the year specified by dt.
 func (dt DateTime) Month() time.Month {
-	return dt.t.Month()
+	return dt.date().Month()
 }
 
 // Day returns the day of the month specified by dt.
 func (dt DateTime) Day() int {
-	return dt.t.Day()
+	return dt.date().Day()
 }
 
 // Hour returns the hour specified by dt.
 func (dt DateTime) Hour() int {
-	return dt.t.Hour()
+	hour, _, _ := dt.Clock()
+	return hour
 }
 
 // Minute returns the minute specified by dt.
 func (dt DateTime) Minute() int {
-	return dt.t.Minute()
+	_, minute, _ := dt.Clock()
+	return minute
 }
 
 // Second returns the second specified by dt.
 func (dt DateTime) Second() int {
-	return dt.t.Second()
+	_, _, second := dt.Clock()
+	return second
+}
+
+// Nanosecond returns the nanosecond offset within the second specified by dt,
+// in the range [0, 999999999].
+func (dt DateTime) Nanosecond() int {
+	return int(dt.nsec)
 }
 
 // Weekday returns the day of the week specified by d.
 func (dt DateTime) Weekday() time.Weekday {
-	return dt.t.Weekday()
+	return dt.date().Weekday()
 }
 
 // ISOWeek returns the ISO 8601 year and week number in which d occurs.
@@ -114,28 +172,38 @@ func (dt DateTime) Weekday() time.Weekday {
 // week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
 // of year n+1.
 func (dt DateTime) ISOWeek() (year, week int) {
-	year, week = dt.t.ISOWeek()
-	return
+	return dt.date().ISOWeek()
 }
 
 // YearDay returns the day of the year specified by D, in the range [1,365] for non-leap years,
 // and [1,366] in leap years.
 func (dt DateTime) YearDay() int {
-	return dt.t.YearDay()
+	return dt.date().YearDay()
+}
+
+// Quarter returns the calendar quarter in which dt occurs, in the range [1,4].
+func (dt DateTime) Quarter() int {
+	return dt.date().Quarter()
 }
 
-// Add returns the local date-time d + duration.
+// Half returns the half of the calendar year in which dt occurs, in the range [1,2].
+func (dt DateTime) Half() int {
+	return dt.date().Half()
+}
+
+// Add returns the local date-time d + duration. The duration
+// is truncated to a multiple of DateTimePrecision.
 func (dt DateTime) Add(duration time.Duration) DateTime {
-	t := dt.t.Add(toSeconds(duration))
-	return DateTime{t: t}
+	duration = toPrecision(duration)
+	return dateTimeFromSeconds(dt.sec+int64(duration/time.Second), int64(dt.nsec)+int64(duration%time.Second))
 }
 
-// Sub returns the duration dt-e, which will be an integral number of seconds.
+// Sub returns the duration dt-e.
 // If the result exceeds the maximum (or minimum) value that can be stored
 // in a Duration, the maximum (or minimum) duration will be returned.
 // To compute dt-duration, use dt.Add(-duration).
 func (dt DateTime) Sub(e DateTime) time.Duration {
-	return dt.t.Sub(e.t)
+	return dt.toTime().Sub(e.toTime())
 }
 
 // AddDate returns the local date-time corresponding to adding the given number of years,
@@ -145,15 +213,48 @@ func (dt DateTime) Sub(e DateTime) time.Duration {
 // AddDate normalizes its result in the same way that Date does, so, for example,
 // adding one month to October 31 yields December 1, the normalized form for November 31.
 func (dt DateTime) AddDate(years int, months int, days int) DateTime {
-	t := dt.t.AddDate(years, months, days)
-	return DateTime{t: t}
+	year, month, day, hour, minute, second := dt.DateTime()
+	return DateTimeForNano(year+years, month+time.Month(months), day+days, hour, minute, second, dt.Nanosecond())
+}
+
+// Truncate returns the result of rounding dt down to a multiple of d
+// (since the zero date-time). If d <= 0, Truncate returns dt unchanged.
+func (dt DateTime) Truncate(d time.Duration) DateTime {
+	return dateTimeFromTime(dt.toTime().Truncate(d))
+}
+
+// Round returns the result of rounding dt to the nearest multiple of d
+// (since the zero date-time). The rounding behavior for halfway values
+// is to round up. If d <= 0, Round returns dt unchanged.
+func (dt DateTime) Round(d time.Duration) DateTime {
+	return dateTimeFromTime(dt.toTime().Round(d))
+}
+
+// toTime returns the time.Time in UTC with the same date and clock as dt.
+// Because time.Time measures rounding from the zero time, which is
+// midnight UTC, January 1, year 1, Truncate and Round give the same
+// results for dt and the time.Time.
+func (dt DateTime) toTime() time.Time {
+	return time.Unix(dt.Unix(), int64(dt.nsec)).UTC()
+}
+
+// dateTimeFromTime returns the DateTime with the same date and clock
+// as t in UTC, without applying DateTimePrecision.
+func dateTimeFromTime(t time.Time) DateTime {
+	return DateTimeFromUnix(t.Unix(), int64(t.Nanosecond()))
+}
+
+// dateTimeFromSeconds returns the DateTime that is sec seconds and nsec
+// nanoseconds after midnight, January 1, year 1, normalizing nsec.
+func dateTimeFromSeconds(sec int64, nsec int64) DateTime {
+	sec += floorDiv64(nsec, nanosecondsPerSecond)
+	nsec -= floorDiv64(nsec, nanosecondsPerSecond) * nanosecondsPerSecond
+	return DateTime{sec: sec, nsec: int32(nsec)}
 }
 
 // toDate converts the time.Time value into a DateTime.,
 func toLocalDateTime(t time.Time) DateTime {
-	y, m, d := t.Date()
-	hour, minute, second := t.Clock()
-	return DateTimeFor(y, m, d, hour, minute, second)
+	return DateTimeFromTime(t)
 }
 
 // Now returns the current local date-time.
@@ -167,16 +268,49 @@ func Now() DateTime {
 // and will be normalized during the conversion.
 // For example, October 32 converts to November 1.
 func DateTimeFor(year int, month time.Month, day int, hour int, minute int, second int) DateTime {
-	return DateTime{
-		t: time.Date(year, month, day, hour, minute, second, 0, time.UTC),
-	}
+	return DateTimeForNano(year, month, day, hour, minute, second, 0)
+}
+
+// DateTimeForNano returns the DateTime corresponding to year, month, day, hour, minute,
+// second and nanosecond. Unlike values created from a time.Time, the nanosecond
+// value is kept regardless of DateTimePrecision.
+//
+// The month, day, hour, minute, second and nanosecond values may be outside their
+// usual ranges and will be normalized during the conversion.
+func DateTimeForNano(year int, month time.Month, day int, hour int, minute int, second int, nanosecond int) DateTime {
+	days := int64(DateFor(year, month, day).days)
+	seconds := days*secondsPerDay + int64(hour)*3600 + int64(minute)*60 + int64(second)
+	return dateTimeFromSeconds(seconds, int64(nanosecond))
+}
+
+// DateTimeFromUnix returns the DateTime corresponding to the given Unix time,
+// sec seconds and nsec nanoseconds since January 1, 1970 UTC. It is the inverse
+// of DateTime.Unix. Like DateTimeForNano, the nanosecond value is kept regardless
+// of DateTimePrecision. It is valid to pass nsec outside the range [0, 999999999].
+func DateTimeFromUnix(sec int64, nsec int64) DateTime {
+	return dateTimeFromSeconds(sec+unixEpochSeconds, nsec)
+}
+
+// DateTimeFromUnixMilli returns the DateTime corresponding to the given
+// number of milliseconds since January 1, 1970 UTC. It is the inverse of
+// DateTime.UnixMilli.
+func DateTimeFromUnixMilli(msec int64) DateTime {
+	return DateTimeFromUnix(floorDiv64(msec, 1e3), (msec-floorDiv64(msec, 1e3)*1e3)*1e6)
+}
+
+// DateTimeFromUnixMicro returns the DateTime corresponding to the given
+// number of microseconds since January 1, 1970 UTC. It is the inverse of
+// DateTime.UnixMicro.
+func DateTimeFromUnixMicro(usec int64) DateTime {
+	return DateTimeFromUnix(floorDiv64(usec, 1e6), (usec-floorDiv64(usec, 1e6)*1e6)*1e3)
 }
 
 // DateTimeFromTime returns the DateTime corresponding to t.
+// Fractional seconds are truncated to DateTimePrecision.
 func DateTimeFromTime(t time.Time) DateTime {
 	year, month, day := t.Date()
 	hour, minute, second := t.Clock()
-	return DateTimeFor(year, month, day, hour, minute, second)
+	return DateTimeForNano(year, month, day, hour, minute, second, t.Nanosecond()).Truncate(DateTimePrecision)
 }
 
 // Format returns a textual representation of the time value formatted
@@ -184,11 +318,12 @@ func DateTimeFromTime(t time.Time) DateTime {
 // time package. Note that with a Date the reference time is
 //  Mon Jan 2 2006 15:04:05.
 func (dt DateTime) Format(layout string) string {
-	return dt.t.Format(layout)
+	return dt.toTime().Format(layout)
 }
 
-// String returns a string representation of d. The date
-// format returned is compatible with ISO 8601: yyyy-mm-dd.
+// String returns a string representation of dt. The date-time
+// format returned is compatible with ISO 8601: yyyy-mm-ddThh:mm:ss.
+// If dt has a fractional second, it is appended with trailing zeros removed.
 func (dt DateTime) String() string {
 	return localDateTimeString(dt)
 }
@@ -201,7 +336,17 @@ func localDateTimeString(dt DateTime) string {
 		year = -year
 		sign = "-"
 	}
-	return fmt.Sprintf("%s%04d-%02d-%02dT%02d:%02d:%02d", sign, year, int(month), day, hour, minute, second)
+	s := fmt.Sprintf("%s%04d-%02d-%02dT%02d:%02d:%02d", sign, year, int(month), day, hour, minute, second)
+	return s + toFractionString(dt.Nanosecond())
+}
+
+// toFractionString returns the decimal fraction of a second for nanosecond,
+// with trailing zeros removed. If nanosecond is zero the string is empty.
+func toFractionString(nanosecond int) string {
+	if nanosecond == 0 {
+		return ""
+	}
+	return strings.TrimRight(fmt.Sprintf(".%09d", nanosecond), "0")
 }
 
 // localDateQuotedString returns the string representation of the date in quotation marks.
@@ -210,12 +355,43 @@ func localDateQuotedString(dt DateTime) string {
 }
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is a version number, followed by the number of seconds since
+// January 1, 1970 as a big-endian int64. If dt has fractional seconds, they are
+// followed by the number of nanoseconds as a big-endian int32.
 func (dt DateTime) MarshalBinary() ([]byte, error) {
-	return dt.t.MarshalBinary()
+	size := 9
+	if dt.Nanosecond() != 0 {
+		size = 13
+	}
+	data := make([]byte, size)
+	data[0] = binaryVersionCompact
+	binary.BigEndian.PutUint64(data[1:], uint64(dt.Unix()))
+	if size == 13 {
+		binary.BigEndian.PutUint32(data[9:], uint32(dt.Nanosecond()))
+	}
+	return data, nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// In addition to the encoding produced by MarshalBinary, the encoding of
+// a time.Time is accepted, which is the encoding produced by earlier
+// versions of this package.
 func (dt *DateTime) UnmarshalBinary(data []byte) error {
+	if len(data) > 0 && data[0] == binaryVersionCompact {
+		var nsec uint32
+		switch len(data) {
+		case 9:
+		case 13:
+			nsec = binary.BigEndian.Uint32(data[9:])
+		default:
+			return errors.New("local.DateTime.UnmarshalBinary: invalid length")
+		}
+		if nsec >= 1e9 {
+			return errors.New("local.DateTime.UnmarshalBinary: invalid nanoseconds")
+		}
+		*dt = DateTimeFromUnix(int64(binary.BigEndian.Uint64(data[1:])), int64(nsec))
+		return nil
+	}
 	var t time.Time
 	if err := t.UnmarshalBinary(data); err != nil {
 		return err
@@ -232,8 +408,17 @@ func (dt DateTime) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // The date is expected to be a quoted string in an ISO 8601
-// format (calendar or ordinal).
+// format (calendar or ordinal). If StrictJSON is set, the date-time
+// must be a quoted RFC 3339 full-date and partial-time.
 func (dt *DateTime) UnmarshalJSON(data []byte) (err error) {
+	if StrictJSON {
+		s, ok := unquoteJSON(data)
+		if !ok {
+			return errInvalidRFC3339DateTime
+		}
+		*dt, err = DateTimeParseRFC3339(s)
+		return
+	}
 	s := string(data)
 	*dt, err = DateTimeParse(s)
 	return
@@ -287,7 +472,5 @@ func (dt *DateTime) Scan(src interface{}) error {
 
 // Value implements the driver.Valuer interface.
 func (dt DateTime) Value() (driver.Value, error) {
-	year, month, day := dt.Date()
-	hour, minute, second := dt.Clock()
-	return time.Date(year, month, day, hour, minute, second, 0, time.UTC), nil
+	return dt.toTime(), nil
 }
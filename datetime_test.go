@@ -91,7 +91,7 @@ func CheckLocalDateTime(t *testing.T, datetime DateTime, year, month, day, hour,
 	assert.Equal(second, datetime.Second())
 
 	// Calculate expected text representation
-	var text = datetime.t.Format("2006-01-02T15:04:05")
+	var text = datetime.Format("2006-01-02T15:04:05")
 
 	assert.Equal(text, datetime.String())
 
@@ -158,13 +158,18 @@ func CheckLocalDateTime(t *testing.T, datetime DateTime, year, month, day, hour,
 	if err != nil {
 		t.Errorf("MarshalBinary: %s: unexpected error: %v", text, err)
 	} else {
-		// binary should be the same as the equivalent time binary
-		tdata, _ := datetime.t.MarshalBinary()
-		assert.Equal(tdata, data)
+		assert.Len(data, 9)
 		var datetime2 DateTime
 		err = datetime2.UnmarshalBinary(data)
 		assert.NoError(err, datetime.String())
+		assert.True(datetime.Equal(datetime2), datetime.String())
 	}
+
+	// unmarshal the time binary produced by earlier versions
+	tdata, _ := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC).MarshalBinary()
+	var datetime3 DateTime
+	assert.NoError(datetime3.UnmarshalBinary(tdata), datetime.String())
+	assert.True(datetime.Equal(datetime3), datetime.String())
 }
 
 func TestParseDateDateTime(t *testing.T) {
@@ -524,6 +529,45 @@ func TestDateTimeUnmarshalBinaryError(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestDateTimeFromUnix(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Sec      int64
+		Nsec     int64
+		Expected DateTime
+	}{
+		{0, 0, DateTimeFor(1970, 1, 1, 0, 0, 0)},
+		{1759244645, 0, DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{-1, 500, DateTimeForNano(1969, 12, 31, 23, 59, 59, 500)},
+		{0, -1, DateTimeForNano(1969, 12, 31, 23, 59, 59, 999999999)},
+	}
+
+	for _, tc := range testCases {
+		dt := DateTimeFromUnix(tc.Sec, tc.Nsec)
+		assert.True(tc.Expected.Equal(dt), "expected %v, actual %v", tc.Expected, dt)
+	}
+}
+
+func TestDateTimeMarshalBinaryCompact(t *testing.T) {
+	assert := assert.New(t)
+	dt := DateTimeFor(2025, 9, 30, 15, 4, 5)
+	data, err := dt.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{0x10, 0, 0, 0, 0, 0x68, 0xdb, 0xf1, 0x65}, data)
+
+	dt = DateTimeForNano(2025, 9, 30, 15, 4, 5, 123456789)
+	data, err = dt.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{0x10, 0, 0, 0, 0, 0x68, 0xdb, 0xf1, 0x65, 0x07, 0x5b, 0xcd, 0x15}, data)
+	var dt2 DateTime
+	assert.NoError(dt2.UnmarshalBinary(data))
+	assert.True(dt.Equal(dt2))
+	assert.Equal(123456789, dt2.Nanosecond())
+
+	assert.Error(dt2.UnmarshalBinary(data[:10]))
+	assert.Error(dt2.UnmarshalBinary([]byte{0x10, 0, 0, 0, 0, 0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00}))
+}
+
 func TestDateTimeAddDate(t *testing.T) {
 	assert := assert.New(t)
 	testCases := []struct {
@@ -635,6 +679,11 @@ func TestDateTimeParseLayout(t *testing.T) {
 			Layout: "02 Jan 2006",
 			Error:  true,
 		},
+		{
+			Text:     "11 Jan 1994 05:45:23 -0800",
+			Layout:   "02 Jan 2006 15:04:05 -0700",
+			Expected: DateTimeFor(1994, 1, 11, 5, 45, 23),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -648,6 +697,359 @@ func TestDateTimeParseLayout(t *testing.T) {
 	}
 }
 
+func TestDateTimeParseLayoutStrict(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Layout   string
+		Expected DateTime
+		Error    bool
+	}{
+		{
+			Text:     "11 Jan 1994 05:45:23",
+			Layout:   "02 Jan 2006 15:04:05",
+			Expected: DateTimeFor(1994, 1, 11, 5, 45, 23),
+		},
+		{
+			Text:   "Jan 11 1994",
+			Layout: "02 Jan 2006",
+			Error:  true,
+		},
+		{
+			Text:   "11 Jan 1994 05:45:23 -0800",
+			Layout: "02 Jan 2006 15:04:05 -0700",
+			Error:  true,
+		},
+		{
+			Text:   "11 Jan 1994 05:45:23 +0000",
+			Layout: "02 Jan 2006 15:04:05 -0700",
+			Error:  true,
+		},
+		{
+			Text:   "11 Jan 1994 05:45:23 +0100",
+			Layout: "02 Jan 2006 15:04:05 -0700",
+			Error:  true,
+		},
+		{
+			Text:   "11 Jan 1994 05:45:23 Z",
+			Layout: "02 Jan 2006 15:04:05 Z07:00",
+			Error:  true,
+		},
+		{
+			Text:   "11 Jan 1994 05:45:23 UTC",
+			Layout: "02 Jan 2006 15:04:05 MST",
+			Error:  true,
+		},
+		{
+			Text:   "11 Jan 1994 05:45:23 AEST",
+			Layout: "02 Jan 2006 15:04:05 MST",
+			Error:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		d, err := DateTimeParseLayoutStrict(tc.Layout, tc.Text)
+		if tc.Error {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+			assert.Equal(tc.Expected, d, dateTimesNotEqual(tc.Expected, d))
+		}
+	}
+}
+
 func dateTimesNotEqual(expected, actual DateTime) string {
 	return fmt.Sprintf("%s vs %s", expected.String(), actual.String())
 }
+
+func TestDateTimeParseOffset(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Expected DateTime
+		Offset   int
+		HasZone  bool
+		Error    bool
+	}{
+		{
+			Text:     "2095-09-30T11:47:03",
+			Expected: DateTimeFor(2095, 9, 30, 11, 47, 3),
+		},
+		{
+			Text:     "2095-09-30T11:47:03Z",
+			Expected: DateTimeFor(2095, 9, 30, 11, 47, 3),
+			HasZone:  true,
+		},
+		{
+			Text:     "2095-09-30T11:47:03.123+10:00",
+			Expected: DateTimeFor(2095, 9, 30, 11, 47, 3),
+			Offset:   10 * 3600,
+			HasZone:  true,
+		},
+		{
+			Text:     "2095-09-30 11:47-0530",
+			Expected: DateTimeFor(2095, 9, 30, 11, 47, 0),
+			Offset:   -(5*3600 + 30*60),
+			HasZone:  true,
+		},
+		{
+			Text:     "2195-060T030211+09",
+			Expected: DateTimeFor(2195, 3, 1, 3, 2, 11),
+			Offset:   9 * 3600,
+			HasZone:  true,
+		},
+		{
+			Text:  "2095-09-30T11:47:03+24:00",
+			Error: true,
+		},
+		{
+			Text:  "2095-09-30T11:47:03+10:60",
+			Error: true,
+		},
+		{
+			Text:  "2095-09-30Z",
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		dt, loc, err := DateTimeParseOffset(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+			continue
+		}
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Expected, dt, dateTimesNotEqual(tc.Expected, dt))
+		if !tc.HasZone {
+			assert.Nil(loc, tc.Text)
+			continue
+		}
+		if assert.NotNil(loc, tc.Text) {
+			_, offset := time.Date(2095, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(tc.Offset, offset, tc.Text)
+		}
+
+		// DateTimeParse does not accept a zone designator
+		_, err = DateTimeParse(tc.Text)
+		assert.Error(err, tc.Text)
+	}
+}
+
+func TestDateTimeParseIn(t *testing.T) {
+	assert := assert.New(t)
+	brisbane := time.FixedZone("Australia/Brisbane", 10*3600)
+	testCases := []struct {
+		Text     string
+		Location *time.Location
+		Expected DateTime
+	}{
+		{
+			Text:     "2095-09-30T11:47:03",
+			Location: brisbane,
+			Expected: DateTimeFor(2095, 9, 30, 11, 47, 3),
+		},
+		{
+			Text:     "2095-09-30T11:47:03Z",
+			Location: brisbane,
+			Expected: DateTimeFor(2095, 9, 30, 21, 47, 3),
+		},
+		{
+			Text:     "2095-09-30T20:47:03-05:00",
+			Location: brisbane,
+			Expected: DateTimeFor(2095, 10, 1, 11, 47, 3),
+		},
+		{
+			Text:     "2095-09-30T11:47:03+10:00",
+			Location: time.UTC,
+			Expected: DateTimeFor(2095, 9, 30, 1, 47, 3),
+		},
+	}
+
+	for _, tc := range testCases {
+		dt, err := DateTimeParseIn(tc.Text, tc.Location)
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Expected, dt, dateTimesNotEqual(tc.Expected, dt))
+	}
+}
+
+func TestDateTimeParseLayoutIn(t *testing.T) {
+	assert := assert.New(t)
+	brisbane := time.FixedZone("Australia/Brisbane", 10*3600)
+	testCases := []struct {
+		Text     string
+		Layout   string
+		Expected DateTime
+		Error    bool
+	}{
+		{
+			Text:     "11 Jan 1994 05:45:23",
+			Layout:   "02 Jan 2006 15:04:05",
+			Expected: DateTimeFor(1994, 1, 11, 5, 45, 23),
+		},
+		{
+			Text:     "11 Jan 1994 05:45:23 -0800",
+			Layout:   "02 Jan 2006 15:04:05 -0700",
+			Expected: DateTimeFor(1994, 1, 11, 23, 45, 23),
+		},
+		{
+			Text:   "Jan 11 1994",
+			Layout: "02 Jan 2006",
+			Error:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		d, err := DateTimeParseLayoutIn(tc.Layout, tc.Text, brisbane)
+		if tc.Error {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+			assert.Equal(tc.Expected, d, dateTimesNotEqual(tc.Expected, d))
+		}
+	}
+}
+
+func TestDateTimePrecision(t *testing.T) {
+	assert := assert.New(t)
+	defer func(precision time.Duration) { DateTimePrecision = precision }(DateTimePrecision)
+
+	testCases := []struct {
+		Precision  time.Duration
+		Text       string
+		Nanosecond int
+		String     string
+	}{
+		{
+			Precision:  time.Second,
+			Text:       "2095-09-30T11:47:03.123456789",
+			Nanosecond: 0,
+			String:     "2095-09-30T11:47:03",
+		},
+		{
+			Precision:  time.Millisecond,
+			Text:       "2095-09-30T11:47:03.123456789",
+			Nanosecond: 123000000,
+			String:     "2095-09-30T11:47:03.123",
+		},
+		{
+			Precision:  time.Microsecond,
+			Text:       "2095-09-30 11:47:03.1",
+			Nanosecond: 100000000,
+			String:     "2095-09-30T11:47:03.1",
+		},
+		{
+			Precision:  time.Nanosecond,
+			Text:       "2095-273T114703.000000001",
+			Nanosecond: 1,
+			String:     "2095-09-30T11:47:03.000000001",
+		},
+	}
+
+	for _, tc := range testCases {
+		DateTimePrecision = tc.Precision
+		dt, err := DateTimeParse(tc.Text)
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Nanosecond, dt.Nanosecond(), tc.Text)
+		assert.Equal(tc.String, dt.String(), tc.Text)
+
+		// round trip through JSON
+		data, err := dt.MarshalJSON()
+		assert.NoError(err)
+		var dt2 DateTime
+		assert.NoError(dt2.UnmarshalJSON(data))
+		assert.Equal(dt, dt2, tc.Text)
+
+		// round trip through the database
+		v, err := dt.Value()
+		assert.NoError(err)
+		var dt3 DateTime
+		assert.NoError(dt3.Scan(v))
+		assert.Equal(dt, dt3, tc.Text)
+	}
+
+	DateTimePrecision = time.Millisecond
+	dt := DateTimeFromTime(time.Date(2095, 9, 30, 11, 47, 3, 999999999, time.UTC))
+	assert.Equal(DateTimeForNano(2095, 9, 30, 11, 47, 3, 999000000), dt)
+	dt = dt.Add(1500 * time.Microsecond)
+	assert.Equal(DateTimeFor(2095, 9, 30, 11, 47, 4), dt)
+}
+
+func TestDateTimeTruncateRound(t *testing.T) {
+	assert := assert.New(t)
+	dt := DateTimeForNano(2095, 9, 30, 11, 47, 3, 567891234)
+	testCases := []struct {
+		Duration  time.Duration
+		Truncated DateTime
+		Rounded   DateTime
+	}{
+		{
+			Duration:  time.Millisecond,
+			Truncated: DateTimeForNano(2095, 9, 30, 11, 47, 3, 567000000),
+			Rounded:   DateTimeForNano(2095, 9, 30, 11, 47, 3, 568000000),
+		},
+		{
+			Duration:  time.Second,
+			Truncated: DateTimeFor(2095, 9, 30, 11, 47, 3),
+			Rounded:   DateTimeFor(2095, 9, 30, 11, 47, 4),
+		},
+		{
+			Duration:  time.Hour,
+			Truncated: DateTimeFor(2095, 9, 30, 11, 0, 0),
+			Rounded:   DateTimeFor(2095, 9, 30, 12, 0, 0),
+		},
+		{
+			Duration:  0,
+			Truncated: dt,
+			Rounded:   dt,
+		},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Truncated, dt.Truncate(tc.Duration), tc.Duration.String())
+		assert.Equal(tc.Rounded, dt.Round(tc.Duration), tc.Duration.String())
+	}
+}
+
+func TestDateTimeComparable(t *testing.T) {
+	assert := assert.New(t)
+	dt1 := DateTimeFor(2025, time.September, 30, 15, 4, 5)
+	dt2 := DateTimeFromTime(time.Date(2025, 9, 30, 15, 4, 5, 0, time.FixedZone("AEST", 10*3600)))
+	var dt3 DateTime
+	data, err := dt1.MarshalBinary()
+	assert.NoError(err)
+	assert.NoError(dt3.UnmarshalBinary(data))
+	assert.True(dt1 == dt2)
+	assert.True(dt1 == dt3)
+	assert.True(DateTimeForNano(2025, time.September, 30, 15, 4, 4, 1e9) == dt1)
+	assert.True(DateTime{} == DateTimeFor(1, time.January, 1, 0, 0, 0))
+
+	m := map[DateTime]int{dt1: 1}
+	m[dt2]++
+	m[dt3]++
+	assert.Equal(map[DateTime]int{dt1: 3}, m)
+}
+
+func TestDateTimeUnixMilliMicro(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		dt    DateTime
+		milli int64
+		micro int64
+		nano  int64
+	}{
+		{DateTimeFor(1970, 1, 1, 0, 0, 0), 0, 0, 0},
+		{DateTimeForNano(2025, 9, 30, 15, 4, 5, 123456789), 1759244645123, 1759244645123456, 1759244645123456789},
+		{DateTimeForNano(1969, 12, 31, 23, 59, 59, 999000000), -1, -1000, -1000000},
+		{DateTimeForNano(1969, 12, 31, 23, 59, 59, 999999000), -1, -1, -1000},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.milli, tc.dt.UnixMilli(), tc.dt.String())
+		assert.Equal(tc.micro, tc.dt.UnixMicro(), tc.dt.String())
+		assert.Equal(tc.nano, tc.dt.UnixNano(), tc.dt.String())
+		tm := time.Unix(0, tc.nano)
+		assert.Equal(tm.UnixMilli(), tc.dt.UnixMilli())
+		assert.Equal(tm.UnixMicro(), tc.dt.UnixMicro())
+		assert.Equal(tc.dt.Truncate(time.Millisecond), DateTimeFromUnixMilli(tc.milli))
+		assert.Equal(tc.dt.Truncate(time.Microsecond), DateTimeFromUnixMicro(tc.micro))
+	}
+}
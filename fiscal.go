@@ -0,0 +1,218 @@
+package local
+
+import (
+	"errors"
+	"time"
+)
+
+var errInvalidWeekPattern = errors.New("invalid fiscal week pattern")
+
+// WeekPattern is the number of weeks in each of the three periods of
+// a quarter in a 52-53 week fiscal calendar.
+type WeekPattern [3]int
+
+// Week patterns commonly used by retail fiscal calendars.
+var (
+	Pattern445 = WeekPattern{4, 4, 5}
+	Pattern454 = WeekPattern{4, 5, 4}
+	Pattern544 = WeekPattern{5, 4, 4}
+)
+
+// FiscalYearEnd specifies how the last day of a 52-53 week fiscal year is chosen.
+type FiscalYearEnd int
+
+// Methods for choosing the last day of a 52-53 week fiscal year.
+const (
+	LastWeekday    FiscalYearEnd = iota // the last occurrence of the weekday in the end month
+	NearestWeekday                      // the occurrence of the weekday nearest to the last day of the end month
+)
+
+// FiscalCalendar divides time into fiscal years, each of which has four
+// quarters, twelve periods and 52 or 53 weeks.
+//
+// A fiscal year is named after the calendar year in which it ends, so
+// when the fiscal year starts in July, fiscal year 2026 runs from
+// 1 July 2025 to 30 June 2026.
+//
+// Use MonthlyFiscalCalendar or WeeklyFiscalCalendar to obtain a FiscalCalendar.
+type FiscalCalendar struct {
+	startMonth time.Month // first month of a monthly calendar
+	endMonth   time.Month // month in which a weekly calendar's year ends
+	endWeekday time.Weekday
+	end        FiscalYearEnd
+	pattern    WeekPattern // zero for a monthly calendar
+}
+
+// FiscalDate identifies the fiscal year, quarter, period and week in which
+// a date occurs. Quarter is in the range [1,4], Period is in the range [1,12]
+// and Week is in the range [1,53].
+type FiscalDate struct {
+	Year    int
+	Quarter int
+	Period  int
+	Week    int
+}
+
+// MonthlyFiscalCalendar returns a fiscal calendar whose years start on the first
+// day of startMonth, and whose periods are calendar months. Weeks are counted
+// in seven day blocks from the start of the fiscal year, so the last week of
+// the year has only one or two days.
+func MonthlyFiscalCalendar(startMonth time.Month) *FiscalCalendar {
+	return &FiscalCalendar{startMonth: startMonth}
+}
+
+// WeeklyFiscalCalendar returns a 52-53 week fiscal calendar whose years end on
+// endWeekday at or near the end of endMonth, as chosen by end. Each quarter has
+// 13 weeks, divided into periods according to pattern. In a year with 53 weeks,
+// the extra week is added to the last period.
+//
+// An error is returned if pattern is not Pattern445, Pattern454 or Pattern544.
+//
+// For example, a calendar whose years end on the Saturday nearest the end of
+// January, with a 4-5-4 pattern, is obtained with:
+//
+//	c, err := WeeklyFiscalCalendar(time.January, time.Saturday, NearestWeekday, Pattern454)
+func WeeklyFiscalCalendar(endMonth time.Month, endWeekday time.Weekday, end FiscalYearEnd, pattern WeekPattern) (*FiscalCalendar, error) {
+	if pattern != Pattern445 && pattern != Pattern454 && pattern != Pattern544 {
+		return nil, errInvalidWeekPattern
+	}
+	return &FiscalCalendar{
+		endMonth:   endMonth,
+		endWeekday: endWeekday,
+		end:        end,
+		pattern:    pattern,
+	}, nil
+}
+
+// isWeekly reports whether c is a 52-53 week calendar.
+func (c *FiscalCalendar) isWeekly() bool {
+	return c.pattern != WeekPattern{}
+}
+
+// FiscalDate returns the fiscal year, quarter, period and week in which d occurs.
+func (c *FiscalCalendar) FiscalDate(d Date) FiscalDate {
+	year := d.Year()
+	for d.Before(c.yearStart(year)) {
+		year--
+	}
+	for d.After(c.yearEnd(year)) {
+		year++
+	}
+	fd := FiscalDate{
+		Year: year,
+		Week: daysBetween(c.yearStart(year), d)/7 + 1,
+	}
+	if c.isWeekly() {
+		fd.Period = 12
+		for period := 1; period < 12; period++ {
+			if d.Before(c.periodStart(year, period+1)) {
+				fd.Period = period
+				break
+			}
+		}
+	} else {
+		fd.Period = floorMod(int(d.Month())-int(c.firstMonth()), 12) + 1
+	}
+	fd.Quarter = (fd.Period-1)/3 + 1
+	return fd
+}
+
+// WeeksInYear returns the number of weeks in the fiscal year, which is
+// 52 or 53. For a monthly calendar, the last week is incomplete.
+func (c *FiscalCalendar) WeeksInYear(year int) int {
+	return (daysBetween(c.yearStart(year), c.yearEnd(year)) + 7) / 7
+}
+
+// YearDates returns the first and last dates of the fiscal year.
+func (c *FiscalCalendar) YearDates(year int) (start, end Date) {
+	return c.yearStart(year), c.yearEnd(year)
+}
+
+// QuarterDates returns the first and last dates of a quarter of the
+// fiscal year. A quarter outside the range [1,4] is normalized, so
+// that quarter 5 of one year is quarter 1 of the next year.
+func (c *FiscalCalendar) QuarterDates(year int, quarter int) (start, end Date) {
+	year, quarter = year+floorDiv(quarter-1, 4), floorMod(quarter-1, 4)+1
+	return c.periodStart(year, quarter*3-2), c.periodEnd(year, quarter*3)
+}
+
+// PeriodDates returns the first and last dates of a period of the
+// fiscal year. A period outside the range [1,12] is normalized, so
+// that period 13 of one year is period 1 of the next year.
+func (c *FiscalCalendar) PeriodDates(year int, period int) (start, end Date) {
+	year, period = year+floorDiv(period-1, 12), floorMod(period-1, 12)+1
+	return c.periodStart(year, period), c.periodEnd(year, period)
+}
+
+// WeekDates returns the first and last dates of a week of the fiscal year.
+// Weeks are counted from the start of the fiscal year, so a week after
+// the last week of the year is in the following year. For a monthly
+// calendar, the last week of the year ends on the last day of the year.
+func (c *FiscalCalendar) WeekDates(year int, week int) (start, end Date) {
+	start = c.yearStart(year).AddDate(0, 0, 7*(week-1))
+	end = start.AddDate(0, 0, 6)
+	if !c.isWeekly() && week <= c.WeeksInYear(year) && end.After(c.yearEnd(year)) {
+		end = c.yearEnd(year)
+	}
+	return start, end
+}
+
+// firstMonth returns the month in which the fiscal year starts.
+func (c *FiscalCalendar) firstMonth() time.Month {
+	if c.isWeekly() {
+		return c.endMonth%12 + 1
+	}
+	if c.startMonth == 0 {
+		return time.January
+	}
+	return c.startMonth
+}
+
+// yearStart returns the first date of the fiscal year.
+func (c *FiscalCalendar) yearStart(year int) Date {
+	if c.isWeekly() {
+		return c.yearEnd(year-1).AddDate(0, 0, 1)
+	}
+	if c.firstMonth() == time.January {
+		return DateFor(year, time.January, 1)
+	}
+	return DateFor(year-1, c.firstMonth(), 1)
+}
+
+// yearEnd returns the last date of the fiscal year.
+func (c *FiscalCalendar) yearEnd(year int) Date {
+	if !c.isWeekly() {
+		return c.yearStart(year+1).AddDate(0, 0, -1)
+	}
+	lastDay := DateFor(year, c.endMonth+1, 0)
+	days := floorMod(int(lastDay.Weekday())-int(c.endWeekday), 7)
+	if c.end == NearestWeekday && days > 3 {
+		days -= 7
+	}
+	return lastDay.AddDate(0, 0, -days)
+}
+
+// periodStart returns the first date of a period in the range [1,12].
+func (c *FiscalCalendar) periodStart(year int, period int) Date {
+	if !c.isWeekly() {
+		return c.yearStart(year).AddDate(0, period-1, 0)
+	}
+	weeks := 13 * ((period - 1) / 3)
+	for i := 0; i < (period-1)%3; i++ {
+		weeks += c.pattern[i]
+	}
+	return c.yearStart(year).AddDate(0, 0, 7*weeks)
+}
+
+// periodEnd returns the last date of a period in the range [1,12].
+func (c *FiscalCalendar) periodEnd(year int, period int) Date {
+	if period == 12 {
+		return c.yearEnd(year)
+	}
+	return c.periodStart(year, period+1).AddDate(0, 0, -1)
+}
+
+// daysBetween returns the number of days from d to e.
+func daysBetween(d, e Date) int {
+	return e.days - d.days
+}
@@ -0,0 +1,116 @@
+package local
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuarterHalf(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Month   time.Month
+		Quarter int
+		Half    int
+	}{
+		{time.January, 1, 1},
+		{time.March, 1, 1},
+		{time.April, 2, 1},
+		{time.June, 2, 1},
+		{time.July, 3, 2},
+		{time.September, 3, 2},
+		{time.October, 4, 2},
+		{time.December, 4, 2},
+	}
+
+	for _, tc := range testCases {
+		d := DateFor(2025, tc.Month, 15)
+		assert.Equal(tc.Quarter, d.Quarter(), tc.Month.String())
+		assert.Equal(tc.Half, d.Half(), tc.Month.String())
+		dt := DateTimeFor(2025, tc.Month, 15, 12, 0, 0)
+		assert.Equal(tc.Quarter, dt.Quarter(), tc.Month.String())
+		assert.Equal(tc.Half, dt.Half(), tc.Month.String())
+	}
+}
+
+func TestMonthlyFiscalCalendar(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		StartMonth time.Month
+		Date       Date
+		Expected   FiscalDate
+	}{
+		{time.July, DateFor(2025, 7, 1), FiscalDate{Year: 2026, Quarter: 1, Period: 1, Week: 1}},
+		{time.July, DateFor(2025, 9, 30), FiscalDate{Year: 2026, Quarter: 1, Period: 3, Week: 14}},
+		{time.July, DateFor(2026, 6, 30), FiscalDate{Year: 2026, Quarter: 4, Period: 12, Week: 53}},
+		{time.April, DateFor(2026, 1, 15), FiscalDate{Year: 2026, Quarter: 4, Period: 10, Week: 42}},
+		{time.April, DateFor(2026, 4, 1), FiscalDate{Year: 2027, Quarter: 1, Period: 1, Week: 1}},
+		{time.January, DateFor(2025, 12, 31), FiscalDate{Year: 2025, Quarter: 4, Period: 12, Week: 53}},
+	}
+
+	for _, tc := range testCases {
+		c := MonthlyFiscalCalendar(tc.StartMonth)
+		assert.Equal(tc.Expected, c.FiscalDate(tc.Date), tc.Date.String())
+	}
+
+	c := MonthlyFiscalCalendar(time.July)
+	assertRange(t, "2025-07-01", "2026-06-30")(c.YearDates(2026))
+	assertRange(t, "2025-10-01", "2025-12-31")(c.QuarterDates(2026, 2))
+	assertRange(t, "2026-07-01", "2026-09-30")(c.QuarterDates(2026, 5))
+	assertRange(t, "2026-02-01", "2026-02-28")(c.PeriodDates(2026, 8))
+	assertRange(t, "2025-06-01", "2025-06-30")(c.PeriodDates(2026, 0))
+	assertRange(t, "2025-07-08", "2025-07-14")(c.WeekDates(2026, 2))
+	assertRange(t, "2026-06-30", "2026-06-30")(c.WeekDates(2026, 53))
+	assert.Equal(53, c.WeeksInYear(2026))
+}
+
+func TestWeeklyFiscalCalendar(t *testing.T) {
+	assert := assert.New(t)
+
+	// retail calendar ending on the Saturday nearest the end of January
+	c, err := WeeklyFiscalCalendar(time.January, time.Saturday, NearestWeekday, Pattern454)
+	assert.NoError(err)
+	assertRange(t, "2023-01-29", "2024-02-03")(c.YearDates(2024))
+	assertRange(t, "2024-02-04", "2025-02-01")(c.YearDates(2025))
+	assert.Equal(53, c.WeeksInYear(2024))
+	assert.Equal(52, c.WeeksInYear(2025))
+	assertRange(t, "2024-02-04", "2024-05-04")(c.QuarterDates(2025, 1))
+	assertRange(t, "2024-03-03", "2024-04-06")(c.PeriodDates(2025, 2))
+	assertRange(t, "2023-12-31", "2024-02-03")(c.PeriodDates(2024, 12))
+	assertRange(t, "2024-01-28", "2024-02-03")(c.WeekDates(2024, 53))
+	assert.Equal(FiscalDate{Year: 2024, Quarter: 4, Period: 12, Week: 53}, c.FiscalDate(DateFor(2024, 2, 3)))
+	assert.Equal(FiscalDate{Year: 2025, Quarter: 1, Period: 1, Week: 1}, c.FiscalDate(DateFor(2024, 2, 4)))
+	assert.Equal(FiscalDate{Year: 2025, Quarter: 1, Period: 2, Week: 5}, c.FiscalDate(DateFor(2024, 3, 3)))
+
+	// calendar ending on the last Sunday in June, with a 4-4-5 pattern
+	c, err = WeeklyFiscalCalendar(time.June, time.Sunday, LastWeekday, Pattern445)
+	assert.NoError(err)
+	assertRange(t, "2024-07-01", "2025-06-29")(c.YearDates(2025))
+	assertRange(t, "2024-07-01", "2024-07-28")(c.PeriodDates(2025, 1))
+	assertRange(t, "2024-08-26", "2024-09-29")(c.PeriodDates(2025, 3))
+	assert.Equal(FiscalDate{Year: 2025, Quarter: 3, Period: 9, Week: 39}, c.FiscalDate(DateFor(2025, 3, 30)))
+}
+
+func TestWeeklyFiscalCalendarPattern(t *testing.T) {
+	assert := assert.New(t)
+	for _, pattern := range []WeekPattern{{}, {4, 4, 4}, {5, 5, 5}, {3, 5, 5}, {4, 4, 6}, {13, 0, 0}} {
+		c, err := WeeklyFiscalCalendar(time.January, time.Saturday, NearestWeekday, pattern)
+		assert.Error(err, "%v", pattern)
+		assert.Nil(c, "%v", pattern)
+	}
+	for _, pattern := range []WeekPattern{Pattern445, Pattern454, Pattern544} {
+		c, err := WeeklyFiscalCalendar(time.January, time.Saturday, NearestWeekday, pattern)
+		assert.NoError(err, "%v", pattern)
+		assert.NotNil(c, "%v", pattern)
+	}
+}
+
+// assertRange returns a function that asserts that a start and end
+// date match the expected values.
+func assertRange(t *testing.T, expectedStart, expectedEnd string) func(start, end Date) {
+	return func(start, end Date) {
+		assert.Equal(t, expectedStart, start.String())
+		assert.Equal(t, expectedEnd, end.String())
+	}
+}
@@ -0,0 +1,133 @@
+package local
+
+// hebrewCalendar implements the arithmetic Hebrew calendar, using the
+// algorithms from Reingold and Dershowitz, Calendrical Calculations.
+type hebrewCalendar struct{}
+
+// hebrewEpoch is the rata die of 1 Tishrei of year 1 in the Hebrew calendar.
+const hebrewEpoch = -1373427
+
+// Hebrew months that are referred to by name.
+const (
+	hebrewNisan      = 1
+	hebrewIyyar      = 2
+	hebrewTammuz     = 4
+	hebrewElul       = 6
+	hebrewTishrei    = 7
+	hebrewMarheshvan = 8
+	hebrewKislev     = 9
+	hebrewTevet      = 10
+	hebrewAdar       = 12
+	hebrewAdarII     = 13
+)
+
+func (hebrewCalendar) Name() string {
+	return "hebrew"
+}
+
+func (c hebrewCalendar) FromDate(d Date) (CalendarDate, error) {
+	n := rataDie(d)
+	approx := floorDiv(98496*(n-hebrewEpoch), 35975351) + 1
+	year := approx - 1
+	for c.newYear(year+1) <= n {
+		year++
+	}
+	month := hebrewTishrei
+	if n >= c.fixed(year, hebrewNisan, 1) {
+		month = hebrewNisan
+	}
+	for n > c.fixed(year, month, c.daysInMonth(year, month)) {
+		month++
+	}
+	day := n - c.fixed(year, month, 1) + 1
+	return CalendarDate{Year: year, Month: month, Day: day}, nil
+}
+
+func (c hebrewCalendar) ToDate(cd CalendarDate) (Date, error) {
+	if cd.Month < 1 || cd.Month > c.monthsInYear(cd.Year) || cd.Day < 1 || cd.Day > c.daysInMonth(cd.Year, cd.Month) {
+		return Date{}, errInvalidCalendarDate
+	}
+	return dateFromRataDie(c.fixed(cd.Year, cd.Month, cd.Day)), nil
+}
+
+func (hebrewCalendar) isLeap(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+func (c hebrewCalendar) monthsInYear(year int) int {
+	if c.isLeap(year) {
+		return hebrewAdarII
+	}
+	return hebrewAdar
+}
+
+// elapsedDays returns the number of days from the epoch to
+// the molad of Tishrei of year, adjusted for the day of the week.
+func (hebrewCalendar) elapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// yearLengthCorrection returns the number of days that the new year
+// is delayed to avoid years of invalid length.
+func (c hebrewCalendar) yearLengthCorrection(year int) int {
+	ny0 := c.elapsedDays(year - 1)
+	ny1 := c.elapsedDays(year)
+	ny2 := c.elapsedDays(year + 1)
+	switch {
+	case ny2-ny1 == 356:
+		return 2
+	case ny1-ny0 == 382:
+		return 1
+	}
+	return 0
+}
+
+// newYear returns the rata die of 1 Tishrei of year.
+func (c hebrewCalendar) newYear(year int) int {
+	return hebrewEpoch + c.elapsedDays(year) + c.yearLengthCorrection(year)
+}
+
+func (c hebrewCalendar) daysInYear(year int) int {
+	return c.newYear(year+1) - c.newYear(year)
+}
+
+func (c hebrewCalendar) daysInMonth(year, month int) int {
+	switch {
+	case month == hebrewIyyar, month == hebrewTammuz, month == hebrewElul,
+		month == hebrewTevet, month == hebrewAdarII:
+		return 29
+	case month == hebrewAdar && !c.isLeap(year):
+		return 29
+	case month == hebrewMarheshvan && c.daysInYear(year)%10 != 5:
+		// Marheshvan is long in years of 355 or 385 days
+		return 29
+	case month == hebrewKislev && c.daysInYear(year)%10 == 3:
+		// Kislev is short in years of 353 or 383 days
+		return 29
+	}
+	return 30
+}
+
+// fixed returns the rata die of a date in the Hebrew calendar.
+func (c hebrewCalendar) fixed(year, month, day int) int {
+	n := c.newYear(year) + day - 1
+	if month < hebrewTishrei {
+		for m := hebrewTishrei; m <= c.monthsInYear(year); m++ {
+			n += c.daysInMonth(year, m)
+		}
+		for m := hebrewNisan; m < month; m++ {
+			n += c.daysInMonth(year, m)
+		}
+	} else {
+		for m := hebrewTishrei; m < month; m++ {
+			n += c.daysInMonth(year, m)
+		}
+	}
+	return n
+}
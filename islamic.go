@@ -0,0 +1,46 @@
+package local
+
+// tabularIslamicCalendar implements the tabular Islamic calendar, using the
+// algorithms from Reingold and Dershowitz, Calendrical Calculations.
+type tabularIslamicCalendar struct{}
+
+// islamicEpoch is the rata die of 1 Muharram of year 1 AH,
+// which is 16 July 622 in the Julian calendar.
+const islamicEpoch = 227015
+
+func (tabularIslamicCalendar) Name() string {
+	return "islamic-civil"
+}
+
+func (c tabularIslamicCalendar) FromDate(d Date) (CalendarDate, error) {
+	n := rataDie(d)
+	year := floorDiv(30*(n-islamicEpoch)+10646, 10631)
+	prior := n - c.fixed(year, 1, 1)
+	month := floorDiv(11*prior+330, 325)
+	day := n - c.fixed(year, month, 1) + 1
+	return CalendarDate{Year: year, Month: month, Day: day}, nil
+}
+
+func (c tabularIslamicCalendar) ToDate(cd CalendarDate) (Date, error) {
+	if cd.Month < 1 || cd.Month > 12 || cd.Day < 1 || cd.Day > c.daysInMonth(cd.Year, cd.Month) {
+		return Date{}, errInvalidCalendarDate
+	}
+	return dateFromRataDie(c.fixed(cd.Year, cd.Month, cd.Day)), nil
+}
+
+func (tabularIslamicCalendar) isLeap(year int) bool {
+	return floorMod(14+11*year, 30) < 11
+}
+
+func (c tabularIslamicCalendar) daysInMonth(year, month int) int {
+	if month%2 == 1 || month == 12 && c.isLeap(year) {
+		return 30
+	}
+	return 29
+}
+
+// fixed returns the rata die of a date in the Islamic calendar.
+func (tabularIslamicCalendar) fixed(year, month, day int) int {
+	return islamicEpoch - 1 + (year-1)*354 + floorDiv(3+11*year, 30) +
+		29*(month-1) + month/2 + day
+}
@@ -0,0 +1,251 @@
+package local
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidISOWeekFormat = errors.New("invalid ISO week format")
+
+// ISOWeek represents a week in the ISO 8601 week-based calendar, identified by
+// a week-based year and a week number. Weeks start on Monday, and week 1 of
+// a year is the week that contains the first Thursday of the calendar year.
+// Useful for timesheets and weekly reports, for example.
+//
+// The zero value of ISOWeek is week 1 of year 1, which starts on
+// Monday January 1 of year 1.
+type ISOWeek struct {
+	monday Date
+}
+
+// ISOWeekFor returns the week of the ISO 8601 week-based year. The week may be
+// outside its usual range and will be normalized, so that week 53 of a year
+// with 52 weeks is week 1 of the following year.
+func ISOWeekFor(year int, week int) ISOWeek {
+	jan4 := DateFor(year, time.January, 4)
+	week1 := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7)
+	return ISOWeek{monday: week1.AddDate(0, 0, 7*(week-1))}
+}
+
+// ISOWeekFromDate returns the ISO 8601 week in which d occurs.
+func ISOWeekFromDate(d Date) ISOWeek {
+	return ISOWeek{monday: d.AddDate(0, 0, -(int(d.Weekday())+6)%7)}
+}
+
+// Year returns the ISO 8601 week-based year of w. The week-based year
+// may differ from the calendar year of the first or last days of the week.
+func (w ISOWeek) Year() int {
+	year, _ := w.monday.ISOWeek()
+	return year
+}
+
+// Week returns the week number of w, in the range [1,53].
+func (w ISOWeek) Week() int {
+	_, week := w.monday.ISOWeek()
+	return week
+}
+
+// After reports whether w is after e.
+func (w ISOWeek) After(e ISOWeek) bool {
+	return w.monday.After(e.monday)
+}
+
+// Before reports whether w is before e.
+func (w ISOWeek) Before(e ISOWeek) bool {
+	return w.monday.Before(e.monday)
+}
+
+// Equal reports whether w and e represent the same week.
+func (w ISOWeek) Equal(e ISOWeek) bool {
+	return w.monday.Equal(e.monday)
+}
+
+// IsZero reports whether w represents the zero week,
+// week 1 of year 1.
+func (w ISOWeek) IsZero() bool {
+	return w.monday.IsZero()
+}
+
+// Add returns the week that is the given number of weeks after w.
+// The number of weeks may be negative.
+func (w ISOWeek) Add(weeks int) ISOWeek {
+	return ISOWeek{monday: w.monday.AddDate(0, 0, 7*weeks)}
+}
+
+// Sub returns the number of weeks from e to w.
+func (w ISOWeek) Sub(e ISOWeek) int {
+	return daysBetween(e.monday, w.monday) / 7
+}
+
+// FirstDate returns the Monday at the start of the week.
+func (w ISOWeek) FirstDate() Date {
+	return w.monday
+}
+
+// LastDate returns the Sunday at the end of the week.
+func (w ISOWeek) LastDate() Date {
+	return w.monday.AddDate(0, 0, 6)
+}
+
+// Contains reports whether d occurs in the week.
+func (w ISOWeek) Contains(d Date) bool {
+	return !d.Before(w.monday) && !d.After(w.LastDate())
+}
+
+// Dates returns the seven days of the week, from Monday to Sunday.
+func (w ISOWeek) Dates() []Date {
+	dates := make([]Date, 7)
+	for i := range dates {
+		dates[i] = w.monday.AddDate(0, 0, i)
+	}
+	return dates
+}
+
+// String returns a string representation of w. The format
+// returned is compatible with ISO 8601: yyyy-Www.
+func (w ISOWeek) String() string {
+	year := w.Year()
+	sign := ""
+	if year < 0 {
+		year = -year
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%04d-W%02d", sign, year, w.Week())
+}
+
+var isoWeekRegexp = struct {
+	lenient *regexp.Regexp
+	strict  *regexp.Regexp
+}{
+	lenient: regexp.MustCompile(`^(-?\d{4})-?[Ww](\d{2})$`),
+	strict:  regexp.MustCompile(`^(-?\d{4})-W(\d{2})$`),
+}
+
+// ISOWeekParse parses a string and returns the ISO 8601 week it represents.
+// Leading and trailing space and quotation marks are ignored. The following
+// formats are recognized: yyyy-Www and yyyyWww. The week must be in the range
+// from 1 to the number of weeks in the year.
+func ISOWeekParse(s string) (ISOWeek, error) {
+	return parseISOWeek(strings.Trim(s, " \t\"'"), isoWeekRegexp.lenient)
+}
+
+func parseISOWeek(s string, re *regexp.Regexp) (ISOWeek, error) {
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return ISOWeek{}, errInvalidISOWeekFormat
+	}
+
+	// no error checking here because matching the regexp
+	// guarantees that parsing the strings will succeed.
+	year, _ := strconv.Atoi(match[1])
+	week, _ := strconv.Atoi(match[2])
+	if week < 1 || week > Year(year).ISOWeeks() {
+		return ISOWeek{}, errInvalidISOWeekFormat
+	}
+	return ISOWeekFor(year, week), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is five bytes: the week-based year as a big-endian int32,
+// followed by the week number.
+func (w ISOWeek) MarshalBinary() ([]byte, error) {
+	year := w.Year()
+	if year < math.MinInt32 || year > math.MaxInt32 {
+		return nil, errors.New("local.ISOWeek.MarshalBinary: year out of range")
+	}
+	data := make([]byte, 5)
+	binary.BigEndian.PutUint32(data, uint32(int32(year)))
+	data[4] = byte(w.Week())
+	return data, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (w *ISOWeek) UnmarshalBinary(data []byte) error {
+	if len(data) != 5 {
+		return errors.New("local.ISOWeek.UnmarshalBinary: invalid data")
+	}
+	year := int(int32(binary.BigEndian.Uint32(data)))
+	week := int(data[4])
+	if week < 1 || week > Year(year).ISOWeeks() {
+		return errors.New("local.ISOWeek.UnmarshalBinary: invalid data")
+	}
+	*w = ISOWeekFor(year, week)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The week is a quoted string in an ISO 8601 format (yyyy-Www).
+func (w ISOWeek) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + w.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The week is expected to be a quoted string in one of the formats
+// recognized by ISOWeekParse. If StrictJSON is set, the week
+// must be a quoted string in the format yyyy-Www.
+func (w *ISOWeek) UnmarshalJSON(data []byte) (err error) {
+	if StrictJSON {
+		s, ok := unquoteJSON(data)
+		if !ok {
+			return errInvalidISOWeekFormat
+		}
+		*w, err = parseISOWeek(s, isoWeekRegexp.strict)
+		return
+	}
+	*w, err = ISOWeekParse(string(data))
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The format is yyyy-Www.
+func (w ISOWeek) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The week is expected to be in one of the formats recognized
+// by ISOWeekParse.
+func (w *ISOWeek) UnmarshalText(data []byte) (err error) {
+	*w, err = ISOWeekParse(string(data))
+	return
+}
+
+// Scan implements the sql.Scanner interface. A string value is expected
+// to be in one of the formats recognized by ISOWeekParse. A time.Time value
+// is converted to the week in which its date occurs.
+func (w *ISOWeek) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		w1, err := ISOWeekParse(v)
+		if err != nil {
+			return err
+		}
+		*w = w1
+	case []byte:
+		w1, err := ISOWeekParse(string(v))
+		if err != nil {
+			return err
+		}
+		*w = w1
+	case time.Time:
+		*w = ISOWeekFromDate(DateFromTime(v))
+	case nil:
+		*w = ISOWeek{}
+	default:
+		return errors.New("cannot convert to local.ISOWeek")
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface. SQL has no week
+// type, so the value is a string in the format yyyy-Www.
+func (w ISOWeek) Value() (driver.Value, error) {
+	return w.String(), nil
+}
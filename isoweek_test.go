@@ -0,0 +1,148 @@
+package local
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISOWeekFor(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Year   int
+		Week   int
+		Monday Date
+		String string
+	}{
+		{2025, 40, DateFor(2025, 9, 29), "2025-W40"},
+		{2025, 1, DateFor(2024, 12, 30), "2025-W01"},
+		{2026, 53, DateFor(2026, 12, 28), "2026-W53"},
+		{2025, 53, DateFor(2025, 12, 29), "2026-W01"},
+		{2025, 0, DateFor(2024, 12, 23), "2024-W52"},
+		{1, 1, DateFor(1, 1, 1), "0001-W01"},
+	}
+
+	for _, tc := range testCases {
+		w := ISOWeekFor(tc.Year, tc.Week)
+		assert.True(tc.Monday.Equal(w.FirstDate()), tc.String)
+		assert.Equal(tc.String, w.String())
+		for _, d := range w.Dates() {
+			assert.True(w.Equal(ISOWeekFromDate(d)), d.String())
+			assert.True(w.Contains(d), d.String())
+		}
+	}
+	assert.True(ISOWeek{}.Equal(ISOWeekFor(1, 1)))
+	assert.True(ISOWeek{}.IsZero())
+}
+
+func TestISOWeekArithmetic(t *testing.T) {
+	assert := assert.New(t)
+	w := ISOWeekFromDate(DateFor(2025, 9, 30))
+	assert.Equal(2025, w.Year())
+	assert.Equal(40, w.Week())
+	assert.True(DateFor(2025, 10, 5).Equal(w.LastDate()))
+	assert.False(w.Contains(DateFor(2025, 10, 6)))
+	assert.Equal("2026-W01", w.Add(13).String())
+	assert.Equal("2024-W40", w.Add(-52).String())
+	assert.Equal(13, w.Add(13).Sub(w))
+	assert.Equal(-2, w.Sub(w.Add(2)))
+	assert.Equal(105646, w.Sub(ISOWeek{}))
+	assert.True(w.After(w.Add(-1)))
+	assert.True(w.Before(w.Add(1)))
+}
+
+func TestISOWeekParse(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Error    bool
+		Expected ISOWeek
+	}{
+		{Text: "2025-W40", Expected: ISOWeekFor(2025, 40)},
+		{Text: "2025W40", Expected: ISOWeekFor(2025, 40)},
+		{Text: " \"2025-w01\" ", Expected: ISOWeekFor(2025, 1)},
+		{Text: "2026-W53", Expected: ISOWeekFor(2026, 53)},
+		{Text: "2025-W53", Error: true},
+		{Text: "2025-W00", Error: true},
+		{Text: "2025-W1", Error: true},
+		{Text: "2025-40", Error: true},
+	}
+
+	for _, tc := range testCases {
+		w, err := ISOWeekParse(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+		} else if assert.NoError(err, tc.Text) {
+			assert.True(tc.Expected.Equal(w), tc.Text)
+		}
+	}
+}
+
+func TestISOWeekMarshal(t *testing.T) {
+	assert := assert.New(t)
+	var v struct {
+		Week ISOWeek `json:"week"`
+	}
+	v.Week = ISOWeekFor(2025, 40)
+	data, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"week":"2025-W40"}`, string(data))
+
+	assert.NoError(json.Unmarshal([]byte(`{"week":"2025w41"}`), &v))
+	assert.Equal("2025-W41", v.Week.String())
+
+	StrictJSON = true
+	defer func() { StrictJSON = false }()
+	assert.Error(json.Unmarshal([]byte(`{"week":"2025w41"}`), &v))
+	assert.NoError(json.Unmarshal([]byte(`{"week":"2025-W42"}`), &v))
+	assert.Equal("2025-W42", v.Week.String())
+
+	text, err := v.Week.MarshalText()
+	assert.NoError(err)
+	assert.Equal("2025-W42", string(text))
+	var w ISOWeek
+	assert.NoError(w.UnmarshalText([]byte("2020-W53")))
+	assert.Equal("2020-W53", w.String())
+
+	data, err = w.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{0, 0, 0x07, 0xe4, 53}, data)
+	var w2 ISOWeek
+	assert.NoError(w2.UnmarshalBinary(data))
+	assert.Equal(w, w2)
+	assert.Error(w2.UnmarshalBinary([]byte{0, 0, 0x07, 0xe5, 53})) // 2021 has 52 weeks
+	assert.Error(w2.UnmarshalBinary(data[:4]))
+}
+
+func TestISOWeekScanValue(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Value    interface{}
+		Error    bool
+		Expected ISOWeek
+	}{
+		{Value: "2025-W40", Expected: ISOWeekFor(2025, 40)},
+		{Value: []byte("2025-W41"), Expected: ISOWeekFor(2025, 41)},
+		{Value: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Expected: ISOWeekFor(2025, 1)},
+		{Value: nil, Expected: ISOWeek{}},
+		{Value: []byte("xxx"), Error: true},
+		{Value: int64(11), Error: true},
+	}
+
+	for _, tc := range testCases {
+		var w ISOWeek
+		err := w.Scan(tc.Value)
+		if tc.Error {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+			assert.True(w.Equal(tc.Expected))
+		}
+	}
+
+	v, err := ISOWeekFor(2025, 40).Value()
+	assert.NoError(err)
+	assert.Equal("2025-W40", v)
+}
@@ -0,0 +1,322 @@
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	errInvalidJSONNumber = errors.New("invalid JSON number for date")
+	errInvalidJSONObject = errors.New("invalid JSON object for date")
+	errInvalidJSONFormat = errors.New("invalid JSON format")
+	errNotWholeDay       = errors.New("date-time is not a whole number of days")
+)
+
+// JSONFormat specifies a JSON representation of Date and DateTime values.
+// The MarshalJSON and UnmarshalJSON methods of Date and DateTime always use
+// JSONString. Use the wrapper types EpochDaysDate, EpochSecondsDate,
+// ObjectDate, EpochSecondsDateTime and ObjectDateTime for struct fields that
+// use another representation, or call the methods of JSONFormat directly.
+type JSONFormat int
+
+const (
+	// JSONString is a quoted ISO 8601 string, such as "2025-09-30"
+	// or "2025-09-30T15:04:05". This is the default representation.
+	JSONString JSONFormat = iota
+
+	// JSONEpochSeconds is a number of seconds since 1970-01-01T00:00:00,
+	// such as 1759190400. A date-time with fractional seconds has a
+	// decimal fraction, such as 1759244645.5.
+	JSONEpochSeconds
+
+	// JSONEpochDays is an integer number of days since 1970-01-01, such
+	// as 20361. A date-time can only be represented if it is at midnight.
+	JSONEpochDays
+
+	// JSONObject is an object with the fields of the date, such as
+	// {"year":2025,"month":9,"day":30}. A date-time also has the fields
+	// "hour", "minute" and "second", and "nanosecond" if it is not zero.
+	JSONObject
+)
+
+// jsonObject is the JSONObject representation.
+type jsonObject struct {
+	Year       *int `json:"year"`
+	Month      *int `json:"month"`
+	Day        *int `json:"day"`
+	Hour       *int `json:"hour,omitempty"`
+	Minute     *int `json:"minute,omitempty"`
+	Second     *int `json:"second,omitempty"`
+	Nanosecond *int `json:"nanosecond,omitempty"`
+}
+
+var jsonNumberRegexp = regexp.MustCompile(`^(-?\d+)(\.\d+)?$`)
+
+// MarshalDate returns the JSON representation of d in format f.
+func (f JSONFormat) MarshalDate(d Date) ([]byte, error) {
+	switch f {
+	case JSONString:
+		return d.MarshalJSON()
+	case JSONEpochSeconds:
+		return strconv.AppendInt(nil, d.Unix(), 10), nil
+	case JSONEpochDays:
+		return strconv.AppendInt(nil, int64(d.Days()), 10), nil
+	case JSONObject:
+		year, month, day := d.Date()
+		m := int(month)
+		return json.Marshal(jsonObject{Year: &year, Month: &m, Day: &day})
+	}
+	return nil, errInvalidJSONFormat
+}
+
+// UnmarshalDate parses the JSON representation of a date in format f.
+// Unless StrictJSON is set, a quoted string in any of the formats accepted by
+// Date.UnmarshalJSON is also accepted. A number of seconds that is not at midnight
+// is truncated to the date.
+func (f JSONFormat) UnmarshalDate(data []byte) (Date, error) {
+	if f == JSONString || len(data) > 0 && data[0] == '"' && !StrictJSON {
+		var d Date
+		err := d.UnmarshalJSON(data)
+		return d, err
+	}
+	switch f {
+	case JSONEpochSeconds:
+		sec, _, err := parseJSONSeconds(data)
+		if err != nil {
+			return Date{}, err
+		}
+		return DateFromDays(int(floorDiv64(sec, secondsPerDay))), nil
+	case JSONEpochDays:
+		days, err := strconv.ParseInt(string(data), 10, 0)
+		if err != nil {
+			return Date{}, errInvalidJSONNumber
+		}
+		return DateFromDays(int(days)), nil
+	case JSONObject:
+		var obj jsonObject
+		if err := json.Unmarshal(data, &obj); err != nil || obj.Year == nil || obj.Month == nil || obj.Day == nil {
+			return Date{}, errInvalidJSONObject
+		}
+		if !isValidDate(*obj.Year, *obj.Month, *obj.Day) || obj.Hour != nil || obj.Minute != nil ||
+			obj.Second != nil || obj.Nanosecond != nil {
+			return Date{}, errInvalidJSONObject
+		}
+		return DateFor(*obj.Year, time.Month(*obj.Month), *obj.Day), nil
+	}
+	return Date{}, errInvalidJSONFormat
+}
+
+// MarshalDateTime returns the JSON representation of dt in format f.
+// An error is returned if the format is JSONEpochDays and dt is not at midnight.
+func (f JSONFormat) MarshalDateTime(dt DateTime) ([]byte, error) {
+	switch f {
+	case JSONString:
+		return dt.MarshalJSON()
+	case JSONEpochSeconds:
+		return appendJSONSeconds(nil, dt.Unix(), dt.Nanosecond()), nil
+	case JSONEpochDays:
+		sec := dt.Unix()
+		if sec%secondsPerDay != 0 || dt.Nanosecond() != 0 {
+			return nil, errNotWholeDay
+		}
+		return strconv.AppendInt(nil, sec/secondsPerDay, 10), nil
+	case JSONObject:
+		year, month, day, hour, minute, second := dt.DateTime()
+		m := int(month)
+		obj := jsonObject{Year: &year, Month: &m, Day: &day, Hour: &hour, Minute: &minute, Second: &second}
+		if nanosecond := dt.Nanosecond(); nanosecond != 0 {
+			obj.Nanosecond = &nanosecond
+		}
+		return json.Marshal(obj)
+	}
+	return nil, errInvalidJSONFormat
+}
+
+// UnmarshalDateTime parses the JSON representation of a date-time in format f.
+// Unless StrictJSON is set, a quoted string in any of the formats accepted by
+// DateTime.UnmarshalJSON is also accepted. Fractional seconds are kept to
+// DateTimePrecision. In the JSONObject format the time fields are optional
+// and default to zero.
+func (f JSONFormat) UnmarshalDateTime(data []byte) (DateTime, error) {
+	if f == JSONString || len(data) > 0 && data[0] == '"' && !StrictJSON {
+		var dt DateTime
+		err := dt.UnmarshalJSON(data)
+		return dt, err
+	}
+	switch f {
+	case JSONEpochSeconds:
+		sec, nsec, err := parseJSONSeconds(data)
+		if err != nil {
+			return DateTime{}, err
+		}
+		return DateTimeFromUnix(sec, int64(nsec)).Truncate(DateTimePrecision), nil
+	case JSONEpochDays:
+		days, err := strconv.ParseInt(string(data), 10, 0)
+		if err != nil || days < math.MinInt64/secondsPerDay || days > math.MaxInt64/secondsPerDay {
+			return DateTime{}, errInvalidJSONNumber
+		}
+		return DateTimeFromUnix(days*secondsPerDay, 0), nil
+	case JSONObject:
+		var obj jsonObject
+		if err := json.Unmarshal(data, &obj); err != nil || obj.Year == nil || obj.Month == nil || obj.Day == nil {
+			return DateTime{}, errInvalidJSONObject
+		}
+		hour, minute, second, nanosecond := intOrZero(obj.Hour), intOrZero(obj.Minute), intOrZero(obj.Second), intOrZero(obj.Nanosecond)
+		if !isValidDate(*obj.Year, *obj.Month, *obj.Day) || !isValidClock(hour, minute, second) ||
+			nanosecond < 0 || nanosecond >= nanosecondsPerSecond {
+			return DateTime{}, errInvalidJSONObject
+		}
+		dt := DateTimeForNano(*obj.Year, time.Month(*obj.Month), *obj.Day, hour, minute, second, nanosecond)
+		return dt.Truncate(DateTimePrecision), nil
+	}
+	return DateTime{}, errInvalidJSONFormat
+}
+
+// intOrZero returns the value of an optional field of a JSON object.
+func intOrZero(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// parseJSONSeconds parses a JSON number of seconds with an optional decimal
+// fraction, returning whole seconds rounded down and the remaining nanoseconds.
+// Exponents are not accepted.
+func parseJSONSeconds(data []byte) (sec int64, nsec int, err error) {
+	match := jsonNumberRegexp.FindSubmatch(data)
+	if match == nil {
+		return 0, 0, errInvalidJSONNumber
+	}
+	sec, err = strconv.ParseInt(string(match[1]), 10, 64)
+	if err != nil {
+		return 0, 0, errInvalidJSONNumber
+	}
+	nsec = parseFraction(string(match[2]))
+	if nsec > 0 && match[1][0] == '-' {
+		if sec == math.MinInt64 {
+			return 0, 0, errInvalidJSONNumber
+		}
+		sec--
+		nsec = nanosecondsPerSecond - nsec
+	}
+	return sec, nsec, nil
+}
+
+// appendJSONSeconds appends a JSON number of seconds, with a decimal
+// fraction if nsec is not zero.
+func appendJSONSeconds(b []byte, sec int64, nsec int) []byte {
+	if nsec == 0 {
+		return strconv.AppendInt(b, sec, 10)
+	}
+	if sec < 0 {
+		// sec is rounded down, so -1.5 seconds is sec=-2, nsec=500000000
+		b = append(b, '-')
+		b = strconv.AppendUint(b, uint64(-(sec + 1)), 10)
+		return append(b, toFractionString(nanosecondsPerSecond-nsec)...)
+	}
+	b = strconv.AppendInt(b, sec, 10)
+	return append(b, toFractionString(nsec)...)
+}
+
+// EpochDaysDate is a Date that is represented in JSON as
+// an integer number of days since 1970-01-01 (JSONEpochDays).
+type EpochDaysDate Date
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d EpochDaysDate) MarshalJSON() ([]byte, error) {
+	return JSONEpochDays.MarshalDate(Date(d))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *EpochDaysDate) UnmarshalJSON(data []byte) error {
+	return unmarshalDateJSON(JSONEpochDays, data, (*Date)(d))
+}
+
+// EpochSecondsDate is a Date that is represented in JSON as
+// a number of seconds since 1970-01-01T00:00:00 (JSONEpochSeconds).
+type EpochSecondsDate Date
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d EpochSecondsDate) MarshalJSON() ([]byte, error) {
+	return JSONEpochSeconds.MarshalDate(Date(d))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *EpochSecondsDate) UnmarshalJSON(data []byte) error {
+	return unmarshalDateJSON(JSONEpochSeconds, data, (*Date)(d))
+}
+
+// ObjectDate is a Date that is represented in JSON as an
+// object with year, month and day fields (JSONObject).
+type ObjectDate Date
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d ObjectDate) MarshalJSON() ([]byte, error) {
+	return JSONObject.MarshalDate(Date(d))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *ObjectDate) UnmarshalJSON(data []byte) error {
+	return unmarshalDateJSON(JSONObject, data, (*Date)(d))
+}
+
+// EpochSecondsDateTime is a DateTime that is represented in JSON as
+// a number of seconds since 1970-01-01T00:00:00 (JSONEpochSeconds).
+type EpochSecondsDateTime DateTime
+
+// MarshalJSON implements the json.Marshaler interface.
+func (dt EpochSecondsDateTime) MarshalJSON() ([]byte, error) {
+	return JSONEpochSeconds.MarshalDateTime(DateTime(dt))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (dt *EpochSecondsDateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalDateTimeJSON(JSONEpochSeconds, data, (*DateTime)(dt))
+}
+
+// ObjectDateTime is a DateTime that is represented in JSON as an
+// object with date and time fields (JSONObject).
+type ObjectDateTime DateTime
+
+// MarshalJSON implements the json.Marshaler interface.
+func (dt ObjectDateTime) MarshalJSON() ([]byte, error) {
+	return JSONObject.MarshalDateTime(DateTime(dt))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (dt *ObjectDateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalDateTimeJSON(JSONObject, data, (*DateTime)(dt))
+}
+
+// unmarshalDateJSON sets d from data in format f. By convention,
+// JSON null leaves d unchanged.
+func unmarshalDateJSON(f JSONFormat, data []byte, d *Date) error {
+	if string(data) == "null" {
+		return nil
+	}
+	d1, err := f.UnmarshalDate(data)
+	if err != nil {
+		return err
+	}
+	*d = d1
+	return nil
+}
+
+// unmarshalDateTimeJSON sets dt from data in format f. By convention,
+// JSON null leaves dt unchanged.
+func unmarshalDateTimeJSON(f JSONFormat, data []byte, dt *DateTime) error {
+	if string(data) == "null" {
+		return nil
+	}
+	dt1, err := f.UnmarshalDateTime(data)
+	if err != nil {
+		return err
+	}
+	*dt = dt1
+	return nil
+}
@@ -0,0 +1,164 @@
+package local
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONFormatDate(t *testing.T) {
+	assert := assert.New(t)
+	d := DateFor(2025, time.September, 30)
+	testCases := []struct {
+		format JSONFormat
+		json   string
+	}{
+		{JSONString, `"2025-09-30"`},
+		{JSONEpochSeconds, `1759190400`},
+		{JSONEpochDays, `20361`},
+		{JSONObject, `{"year":2025,"month":9,"day":30}`},
+	}
+	for _, tc := range testCases {
+		data, err := tc.format.MarshalDate(d)
+		assert.NoError(err)
+		assert.Equal(tc.json, string(data))
+		d2, err := tc.format.UnmarshalDate(data)
+		assert.NoError(err)
+		assert.Equal(d, d2)
+
+		// all formats accept a string unless StrictJSON is set
+		d2, err = tc.format.UnmarshalDate([]byte(`"20250930"`))
+		assert.NoError(err)
+		assert.Equal(d, d2)
+	}
+
+	before := DateFor(1969, time.December, 31)
+	d2, err := JSONEpochSeconds.UnmarshalDate([]byte(`-1`))
+	assert.NoError(err)
+	assert.Equal(before, d2)
+	d2, err = JSONEpochDays.UnmarshalDate([]byte(`-1`))
+	assert.NoError(err)
+	assert.Equal(before, d2)
+
+	for _, tc := range []struct {
+		format JSONFormat
+		json   string
+	}{
+		{JSONEpochDays, `1.5`},
+		{JSONEpochDays, `{}`},
+		{JSONEpochSeconds, `1e9`},
+		{JSONEpochSeconds, `"xxx"`},
+		{JSONObject, `{"year":2025,"month":2,"day":29}`},
+		{JSONObject, `{"year":2025,"month":2}`},
+		{JSONObject, `{"year":2025,"month":2,"day":1,"hour":1}`},
+		{JSONObject, `20361`},
+		{JSONString, `20361`},
+		{JSONFormat(99), `20361`},
+	} {
+		_, err := tc.format.UnmarshalDate([]byte(tc.json))
+		assert.Error(err, tc.json)
+	}
+	_, err = JSONFormat(99).MarshalDate(d)
+	assert.Error(err)
+}
+
+func TestJSONFormatDateTime(t *testing.T) {
+	assert := assert.New(t)
+	defer func(p time.Duration) { DateTimePrecision = p }(DateTimePrecision)
+	DateTimePrecision = time.Nanosecond
+
+	testCases := []struct {
+		dt     DateTime
+		format JSONFormat
+		json   string
+	}{
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), JSONString, `"2025-09-30T15:04:05"`},
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), JSONEpochSeconds, `1759244645`},
+		{DateTimeForNano(2025, 9, 30, 15, 4, 5, 500000000), JSONEpochSeconds, `1759244645.5`},
+		{DateTimeForNano(1969, 12, 31, 23, 59, 58, 500000000), JSONEpochSeconds, `-1.5`},
+		{DateTimeForNano(1969, 12, 31, 23, 59, 59, 999999999), JSONEpochSeconds, `-0.000000001`},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), JSONEpochDays, `20361`},
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), JSONObject, `{"year":2025,"month":9,"day":30,"hour":15,"minute":4,"second":5}`},
+		{DateTimeForNano(2025, 9, 30, 0, 0, 0, 1), JSONObject, `{"year":2025,"month":9,"day":30,"hour":0,"minute":0,"second":0,"nanosecond":1}`},
+	}
+	for _, tc := range testCases {
+		data, err := tc.format.MarshalDateTime(tc.dt)
+		assert.NoError(err)
+		assert.Equal(tc.json, string(data))
+		dt, err := tc.format.UnmarshalDateTime(data)
+		assert.NoError(err)
+		assert.Equal(tc.dt, dt, tc.json)
+	}
+
+	dt, err := JSONObject.UnmarshalDateTime([]byte(`{"year":2025,"month":9,"day":30}`))
+	assert.NoError(err)
+	assert.Equal(DateTimeFor(2025, 9, 30, 0, 0, 0), dt)
+
+	_, err = JSONEpochDays.MarshalDateTime(DateTimeFor(2025, 9, 30, 15, 4, 5))
+	assert.Error(err)
+	for _, tc := range []struct {
+		format JSONFormat
+		json   string
+	}{
+		{JSONEpochSeconds, `-`},
+		{JSONEpochSeconds, `99999999999999999999`},
+		{JSONEpochDays, `1.0`},
+		{JSONObject, `{"year":2025,"month":9,"day":30,"hour":24}`},
+		{JSONObject, `{"year":2025,"month":9,"day":30,"nanosecond":1000000000}`},
+	} {
+		_, err := tc.format.UnmarshalDateTime([]byte(tc.json))
+		assert.Error(err, tc.json)
+	}
+}
+
+func TestJSONFormatWrappers(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		Days     EpochDaysDate        `json:"days"`
+		Seconds  EpochSecondsDate     `json:"seconds"`
+		Object   ObjectDate           `json:"object"`
+		Updated  EpochSecondsDateTime `json:"updated"`
+		Schedule ObjectDateTime       `json:"schedule"`
+	}
+	d := DateFor(2025, time.September, 30)
+	dt := DateTimeFor(2025, time.September, 30, 15, 4, 5)
+	v := testStruct{
+		Days:     EpochDaysDate(d),
+		Seconds:  EpochSecondsDate(d),
+		Object:   ObjectDate(d),
+		Updated:  EpochSecondsDateTime(dt),
+		Schedule: ObjectDateTime(dt),
+	}
+	data, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"days":20361,"seconds":1759190400,"object":{"year":2025,"month":9,"day":30},`+
+		`"updated":1759244645,"schedule":{"year":2025,"month":9,"day":30,"hour":15,"minute":4,"second":5}}`, string(data))
+
+	var v2 testStruct
+	assert.NoError(json.Unmarshal(data, &v2))
+	assert.Equal(v, v2)
+	assert.Equal(d, Date(v2.Days))
+
+	// null leaves the values unchanged
+	assert.NoError(json.Unmarshal([]byte(`{"days":null,"updated":null}`), &v2))
+	assert.Equal(v, v2)
+
+	assert.Error(json.Unmarshal([]byte(`{"days":"xxx"}`), &v2))
+	assert.Error(json.Unmarshal([]byte(`{"schedule":1}`), &v2))
+}
+
+func TestJSONFormatStrict(t *testing.T) {
+	assert := assert.New(t)
+	defer func(strict bool) { StrictJSON = strict }(StrictJSON)
+
+	StrictJSON = true
+	_, err := JSONEpochDays.UnmarshalDate([]byte(`"2025-09-30"`))
+	assert.Error(err)
+	_, err = JSONObject.UnmarshalDateTime([]byte(`"2025-09-30T15:04:05"`))
+	assert.Error(err)
+	d, err := JSONEpochDays.UnmarshalDate([]byte(`20361`))
+	assert.NoError(err)
+	assert.Equal(DateFor(2025, time.September, 30), d)
+}
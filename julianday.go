@@ -0,0 +1,97 @@
+package local
+
+import (
+	"math"
+	"time"
+)
+
+// Offsets between day numbering systems.
+const (
+	julianDayRataDie         = 1721425 // Julian Day Number of rata die 0
+	modifiedJulianDayRataDie = -678576 // Modified Julian Day of rata die 0
+)
+
+// JulianDay returns the Julian Day Number of d, which is the number
+// of days since 1 January 4713 BC in the proleptic Julian calendar.
+// The Julian Day Number of 1 January 2000 is 2451545.
+func (d Date) JulianDay() int {
+	return rataDie(d) + julianDayRataDie
+}
+
+// DateFromJulianDay returns the date with the given Julian Day Number.
+func DateFromJulianDay(jdn int) Date {
+	return dateFromRataDie(jdn - julianDayRataDie)
+}
+
+// ModifiedJulianDay returns the Modified Julian Day of d, which is the
+// number of days since 17 November 1858. The Modified Julian Day of
+// 1 January 2000 is 51544.
+func (d Date) ModifiedJulianDay() int {
+	return rataDie(d) + modifiedJulianDayRataDie
+}
+
+// DateFromModifiedJulianDay returns the date with the given Modified Julian Day.
+func DateFromModifiedJulianDay(mjd int) Date {
+	return dateFromRataDie(mjd - modifiedJulianDayRataDie)
+}
+
+// RataDie returns the rata die of d, which is the number of days since
+// 31 December of year 0, so that 1 January of year 1 is day 1.
+func (d Date) RataDie() int {
+	return rataDie(d)
+}
+
+// DateFromRataDie returns the date with the given rata die.
+func DateFromRataDie(rd int) Date {
+	return dateFromRataDie(rd)
+}
+
+// JulianDate returns the Julian Date of dt, which is the Julian Day Number
+// plus the fraction of the day since noon. Because Julian Days start at noon,
+// the Julian Date of midnight at the start of 1 January 2000 is 2451544.5.
+func (dt DateTime) JulianDate() float64 {
+	return float64(dt.dayNumber()+julianDayRataDie) - 0.5 + dt.dayFraction()
+}
+
+// DateTimeFromJulianDate returns the date-time with the given Julian Date.
+// The time of day is rounded to the nearest millisecond, which is close to
+// the precision of a Julian Date stored as a float64, and is then truncated
+// to DateTimePrecision.
+func DateTimeFromJulianDate(jd float64) DateTime {
+	return dateTimeFromDayNumber(jd+0.5, julianDayRataDie)
+}
+
+// ModifiedJulianDate returns the Modified Julian Date of dt, which is the
+// Modified Julian Day plus the fraction of the day since midnight.
+func (dt DateTime) ModifiedJulianDate() float64 {
+	return float64(dt.dayNumber()+modifiedJulianDayRataDie) + dt.dayFraction()
+}
+
+// DateTimeFromModifiedJulianDate returns the date-time with the given Modified
+// Julian Date. The time of day is rounded to the nearest millisecond and then
+// truncated to DateTimePrecision.
+func DateTimeFromModifiedJulianDate(mjd float64) DateTime {
+	return dateTimeFromDayNumber(mjd, modifiedJulianDayRataDie)
+}
+
+// dayNumber returns the rata die of the date of dt.
+func (dt DateTime) dayNumber() int {
+	return rataDie(dt.date())
+}
+
+// dayFraction returns the fraction of the day that has elapsed
+// since midnight.
+func (dt DateTime) dayFraction() float64 {
+	hour, minute, second := dt.Clock()
+	elapsed := time.Duration((hour*60+minute)*60+second)*time.Second + time.Duration(dt.Nanosecond())
+	return float64(elapsed) / float64(24*time.Hour)
+}
+
+// dateTimeFromDayNumber returns the date-time for a fractional day number
+// that starts at midnight, where offset is the day number of rata die 0.
+func dateTimeFromDayNumber(n float64, offset int) DateTime {
+	days := math.Floor(n)
+	ms := math.Round((n - days) * float64(24*time.Hour/time.Millisecond))
+	year, month, day := dateFromRataDie(int(days) - offset).Date()
+	return DateTimeFor(year, month, day, 0, 0, 0).Add(time.Duration(ms) * time.Millisecond)
+}
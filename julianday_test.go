@@ -0,0 +1,61 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJulianDay(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Date              Date
+		JulianDay         int
+		ModifiedJulianDay int
+		RataDie           int
+	}{
+		{DateFor(2000, 1, 1), 2451545, 51544, 730120},
+		{DateFor(1970, 1, 1), 2440588, 40587, 719163},
+		{DateFor(1858, 11, 17), 2400001, 0, 678576},
+		{DateFor(1, 1, 1), 1721426, -678575, 1},
+		{DateFor(0, 12, 31), 1721425, -678576, 0},
+		{DateFor(-4713, 11, 24), 0, -2400001, -1721425},
+		{DateFor(-9999, 1, 1), -1930999, -4331000, -3652424},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.JulianDay, tc.Date.JulianDay(), tc.Date.String())
+		assert.Equal(tc.ModifiedJulianDay, tc.Date.ModifiedJulianDay(), tc.Date.String())
+		assert.Equal(tc.RataDie, tc.Date.RataDie(), tc.Date.String())
+		assert.True(tc.Date.Equal(DateFromJulianDay(tc.JulianDay)), tc.Date.String())
+		assert.True(tc.Date.Equal(DateFromModifiedJulianDay(tc.ModifiedJulianDay)), tc.Date.String())
+		assert.True(tc.Date.Equal(DateFromRataDie(tc.RataDie)), tc.Date.String())
+	}
+}
+
+func TestJulianDate(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		DateTime           DateTime
+		JulianDate         float64
+		ModifiedJulianDate float64
+	}{
+		{DateTimeFor(2000, 1, 1, 12, 0, 0), 2451545.0, 51544.5},
+		{DateTimeFor(2000, 1, 1, 0, 0, 0), 2451544.5, 51544.0},
+		{DateTimeFor(1858, 11, 17, 6, 0, 0), 2400000.75, 0.25},
+		{DateTimeFor(-4713, 11, 24, 18, 0, 0), 0.25, -2400000.25},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.JulianDate, tc.DateTime.JulianDate(), tc.DateTime.String())
+		assert.Equal(tc.ModifiedJulianDate, tc.DateTime.ModifiedJulianDate(), tc.DateTime.String())
+		assert.True(tc.DateTime.Equal(DateTimeFromJulianDate(tc.JulianDate)), tc.DateTime.String())
+		assert.True(tc.DateTime.Equal(DateTimeFromModifiedJulianDate(tc.ModifiedJulianDate)), tc.DateTime.String())
+	}
+
+	dt := DateTimeFor(2025, 9, 30, 15, 4, 5)
+	assert.True(dt.Equal(DateTimeFromJulianDate(dt.JulianDate())))
+	assert.True(dt.Equal(DateTimeFromModifiedJulianDate(dt.ModifiedJulianDate())))
+	assert.Equal("2025-09-30T23:59:59", DateTimeFromModifiedJulianDate(60948.99999999).String())
+	assert.Equal("2025-10-01T00:00:00", DateTimeFromModifiedJulianDate(60948.999999999).String())
+}
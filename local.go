@@ -10,6 +10,6 @@
 // timezone might not be relevant in the context.
 //
 // Like the standard library time package, the local package uses a Gregorian calendar
-// for all calculations. The local package makes use of the time package for all
-// of its date-time calculations.
+// for all calculations. Dates and date-times are stored as integer counts of days
+// and seconds, so they can be compared using == and used as map keys.
 package local
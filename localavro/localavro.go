@@ -0,0 +1,166 @@
+// Package localavro maps the local package types to Apache Avro logical types.
+//
+// A local.Date is represented by the Avro "date" logical type, which annotates
+// an int containing the number of days since January 1, 1970. A local.DateTime
+// is represented by the "local-timestamp-millis" and "local-timestamp-micros"
+// logical types, which annotate a long containing the number of milliseconds or
+// microseconds since 1970-01-01T00:00:00, without reference to a timezone.
+//
+// The To and From functions convert between the local types and the values
+// of the underlying Avro types, for use with Avro libraries that do not know
+// about the local package. The Append and Read functions implement the Avro
+// binary encoding directly.
+package localavro
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/spkg/local"
+)
+
+// Schemas for the Avro logical types.
+const (
+	DateSchema                 = `{"type":"int","logicalType":"date"}`
+	LocalTimestampMillisSchema = `{"type":"long","logicalType":"local-timestamp-millis"}`
+	LocalTimestampMicrosSchema = `{"type":"long","logicalType":"local-timestamp-micros"}`
+)
+
+var (
+	errDateOutOfRange     = errors.New("localavro: date out of range for Avro int")
+	errDateTimeOutOfRange = errors.New("localavro: date-time out of range for Avro long")
+	errInvalidEncoding    = errors.New("localavro: invalid binary encoding")
+	errIntOutOfRange      = errors.New("localavro: encoded value out of range for Avro int")
+)
+
+// ToDate returns the value of the Avro "date" logical type for d,
+// the number of days since January 1, 1970.
+func ToDate(d local.Date) (int32, error) {
+	days, ok := d.UnixDays()
+	if !ok {
+		return 0, errDateOutOfRange
+	}
+	return days, nil
+}
+
+// FromDate returns the local date for a value of the Avro "date" logical type.
+func FromDate(days int32) local.Date {
+	return local.DateFromUnixDays(days)
+}
+
+// ToLocalTimestampMillis returns the value of the Avro "local-timestamp-millis"
+// logical type for dt. Any part of a millisecond is truncated.
+func ToLocalTimestampMillis(dt local.DateTime) (int64, error) {
+	if !inRange(dt, 1e3) {
+		return 0, errDateTimeOutOfRange
+	}
+	return dt.UnixMilli(), nil
+}
+
+// FromLocalTimestampMillis returns the local date-time for a value of the
+// Avro "local-timestamp-millis" logical type.
+func FromLocalTimestampMillis(msec int64) local.DateTime {
+	return local.DateTimeFromUnixMilli(msec)
+}
+
+// ToLocalTimestampMicros returns the value of the Avro "local-timestamp-micros"
+// logical type for dt. Any part of a microsecond is truncated.
+func ToLocalTimestampMicros(dt local.DateTime) (int64, error) {
+	if !inRange(dt, 1e6) {
+		return 0, errDateTimeOutOfRange
+	}
+	return dt.UnixMicro(), nil
+}
+
+// FromLocalTimestampMicros returns the local date-time for a value of the
+// Avro "local-timestamp-micros" logical type.
+func FromLocalTimestampMicros(usec int64) local.DateTime {
+	return local.DateTimeFromUnixMicro(usec)
+}
+
+// AppendDate appends the Avro binary encoding of d as a "date" to b.
+func AppendDate(b []byte, d local.Date) ([]byte, error) {
+	days, err := ToDate(d)
+	if err != nil {
+		return b, err
+	}
+	return appendLong(b, int64(days)), nil
+}
+
+// AppendLocalTimestampMillis appends the Avro binary encoding of dt
+// as a "local-timestamp-millis" to b.
+func AppendLocalTimestampMillis(b []byte, dt local.DateTime) ([]byte, error) {
+	msec, err := ToLocalTimestampMillis(dt)
+	if err != nil {
+		return b, err
+	}
+	return appendLong(b, msec), nil
+}
+
+// AppendLocalTimestampMicros appends the Avro binary encoding of dt
+// as a "local-timestamp-micros" to b.
+func AppendLocalTimestampMicros(b []byte, dt local.DateTime) ([]byte, error) {
+	usec, err := ToLocalTimestampMicros(dt)
+	if err != nil {
+		return b, err
+	}
+	return appendLong(b, usec), nil
+}
+
+// ReadDate reads the Avro binary encoding of a "date" from the start of b.
+// It returns the date and the number of bytes read.
+func ReadDate(b []byte) (local.Date, int, error) {
+	days, n, err := readLong(b)
+	if err != nil {
+		return local.Date{}, 0, err
+	}
+	if n > binary.MaxVarintLen32 || days < math.MinInt32 || days > math.MaxInt32 {
+		return local.Date{}, 0, errIntOutOfRange
+	}
+	return FromDate(int32(days)), n, nil
+}
+
+// ReadLocalTimestampMillis reads the Avro binary encoding of a "local-timestamp-millis"
+// from the start of b. It returns the date-time and the number of bytes read.
+func ReadLocalTimestampMillis(b []byte) (local.DateTime, int, error) {
+	msec, n, err := readLong(b)
+	if err != nil {
+		return local.DateTime{}, 0, err
+	}
+	return FromLocalTimestampMillis(msec), n, nil
+}
+
+// ReadLocalTimestampMicros reads the Avro binary encoding of a "local-timestamp-micros"
+// from the start of b. It returns the date-time and the number of bytes read.
+func ReadLocalTimestampMicros(b []byte) (local.DateTime, int, error) {
+	usec, n, err := readLong(b)
+	if err != nil {
+		return local.DateTime{}, 0, err
+	}
+	return FromLocalTimestampMicros(usec), n, nil
+}
+
+// appendLong appends a zig-zag encoded variable-length integer to b.
+func appendLong(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+// readLong reads a zig-zag encoded variable-length integer, which is the
+// Avro binary encoding of both int and long.
+func readLong(b []byte) (int64, int, error) {
+	v, n := binary.Varint(b)
+	if n <= 0 {
+		return 0, 0, errInvalidEncoding
+	}
+	return v, n, nil
+}
+
+// inRange reports whether the number of seconds since January 1, 1970 to dt,
+// multiplied by scale, can be represented as an int64.
+func inRange(dt local.DateTime, scale int64) bool {
+	sec := dt.Unix()
+	return sec >= math.MinInt64/scale && sec < math.MaxInt64/scale
+}
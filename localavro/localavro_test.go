@@ -0,0 +1,81 @@
+package localavro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkg/local"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		date    local.Date
+		days    int32
+		encoded []byte
+	}{
+		{local.DateFor(1970, time.January, 1), 0, []byte{0x00}},
+		{local.DateFor(1969, time.December, 31), -1, []byte{0x01}},
+		{local.DateFor(1970, time.January, 2), 1, []byte{0x02}},
+		{local.DateFor(2025, time.September, 30), 20361, []byte{0x92, 0xbe, 0x02}},
+	}
+	for _, tc := range testCases {
+		days, err := ToDate(tc.date)
+		assert.NoError(err)
+		assert.Equal(tc.days, days)
+		assert.Equal(tc.date, FromDate(days))
+
+		b, err := AppendDate(nil, tc.date)
+		assert.NoError(err)
+		assert.Equal(tc.encoded, b)
+		d, n, err := ReadDate(append(b, 0xff))
+		assert.NoError(err)
+		assert.Equal(len(tc.encoded), n)
+		assert.Equal(tc.date, d)
+	}
+
+	_, err := AppendDate(nil, local.DateFromDays(-1<<31-1))
+	assert.Error(err)
+	_, _, err = ReadDate([]byte{0x80})
+	assert.Error(err)
+	_, _, err = ReadDate([]byte{0x80, 0x80, 0x80, 0x80, 0x10})
+	assert.Error(err)
+}
+
+func TestLocalTimestamp(t *testing.T) {
+	assert := assert.New(t)
+	dt := local.DateTimeForNano(2025, time.September, 30, 15, 4, 5, 123456789)
+
+	msec, err := ToLocalTimestampMillis(dt)
+	assert.NoError(err)
+	assert.Equal(int64(1759244645123), msec)
+	assert.Equal(dt.Truncate(time.Millisecond), FromLocalTimestampMillis(msec))
+
+	usec, err := ToLocalTimestampMicros(dt)
+	assert.NoError(err)
+	assert.Equal(int64(1759244645123456), usec)
+	assert.Equal(dt.Truncate(time.Microsecond), FromLocalTimestampMicros(usec))
+
+	b, err := AppendLocalTimestampMillis(nil, dt)
+	assert.NoError(err)
+	b, err = AppendLocalTimestampMicros(b, dt)
+	assert.NoError(err)
+	dt2, n, err := ReadLocalTimestampMillis(b)
+	assert.NoError(err)
+	assert.Equal(dt.Truncate(time.Millisecond), dt2)
+	dt2, _, err = ReadLocalTimestampMicros(b[n:])
+	assert.NoError(err)
+	assert.Equal(dt.Truncate(time.Microsecond), dt2)
+
+	b, err = AppendLocalTimestampMillis(nil, local.DateTimeFor(1969, time.December, 31, 23, 59, 59))
+	assert.NoError(err)
+	assert.Equal([]byte{0xcf, 0x0f}, b) // -1000
+
+	_, err = ToLocalTimestampMicros(local.DateTimeFor(300000, time.January, 1, 0, 0, 0))
+	assert.Error(err)
+	_, err = ToLocalTimestampMillis(local.DateTimeFor(300000, time.January, 1, 0, 0, 0))
+	assert.NoError(err)
+	_, _, err = ReadLocalTimestampMillis(nil)
+	assert.Error(err)
+}
@@ -0,0 +1,15 @@
+module github.com/spkg/local/localbson
+
+go 1.19
+
+require (
+	github.com/spkg/local v1.0.0
+	github.com/stretchr/testify v1.9.0
+	go.mongodb.org/mongo-driver v1.17.6
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,175 @@
+// Package localbson encodes local.Date and local.DateTime values as BSON
+// values for use with version 1 of the MongoDB Go driver (go.mongodb.org/mongo-driver).
+//
+// The driver cannot see inside the local types, so without this package
+// they are stored as empty embedded documents. The types in this package
+// implement the driver's ValueMarshaler and ValueUnmarshaler interfaces.
+// Date and DateTime are stored as BSON strings in ISO 8601 format, which sort
+// correctly and are easy to read. UTCDate and UTCDateTime are stored as BSON
+// UTC datetimes, with the local date-time treated as if it were in UTC,
+// which allows the use of MongoDB date operators.
+//
+// All of the types decode both BSON strings and BSON UTC datetimes, and
+// decode BSON null as the zero value. Convert between the types in this
+// package and the local types with a type conversion:
+//
+//	bd := localbson.Date(d)
+//	d = local.Date(bd)
+package localbson
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/spkg/local"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var errInvalidBSON = errors.New("localbson: invalid BSON value")
+
+// Date is a local.Date that is stored as a BSON string (yyyy-mm-dd).
+type Date local.Date
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (d Date) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.String, appendString(nil, local.Date(d).String()), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface.
+// A string may be in any of the formats recognized by local.DateParse.
+// A UTC datetime is converted to its date in UTC.
+func (d *Date) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return unmarshalDate(t, data, (*local.Date)(d))
+}
+
+// UTCDate is a local.Date that is stored as a BSON UTC datetime
+// at midnight UTC on the date.
+type UTCDate local.Date
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (d UTCDate) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.DateTime, appendDateTime(nil, local.Date(d).Unix()*1000), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface.
+// A string may be in any of the formats recognized by local.DateParse.
+// A UTC datetime is converted to its date in UTC.
+func (d *UTCDate) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return unmarshalDate(t, data, (*local.Date)(d))
+}
+
+// DateTime is a local.DateTime that is stored as a BSON string
+// (yyyy-mm-ddThh:mm:ss, with fractional seconds if present).
+type DateTime local.DateTime
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (dt DateTime) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.String, appendString(nil, local.DateTime(dt).String()), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface.
+// A string may be in any of the formats recognized by local.DateTimeParse.
+// A UTC datetime is converted to its date and time in UTC.
+func (dt *DateTime) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return unmarshalDateTime(t, data, (*local.DateTime)(dt))
+}
+
+// UTCDateTime is a local.DateTime that is stored as a BSON UTC datetime,
+// as if the local date-time were in UTC. BSON datetimes have millisecond
+// precision, so any part of a millisecond is truncated.
+type UTCDateTime local.DateTime
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (dt UTCDateTime) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.DateTime, appendDateTime(nil, local.DateTime(dt).UnixMilli()), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface.
+// A string may be in any of the formats recognized by local.DateTimeParse.
+// A UTC datetime is converted to its date and time in UTC.
+func (dt *UTCDateTime) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	return unmarshalDateTime(t, data, (*local.DateTime)(dt))
+}
+
+func unmarshalDate(t bsontype.Type, data []byte, d *local.Date) error {
+	switch t {
+	case bsontype.String:
+		s, err := readString(data)
+		if err != nil {
+			return err
+		}
+		d1, err := local.DateParse(s)
+		if err != nil {
+			return err
+		}
+		*d = d1
+	case bsontype.DateTime:
+		msec, err := readDateTime(data)
+		if err != nil {
+			return err
+		}
+		*d = local.DateFor(local.DateTimeFromUnixMilli(msec).Date())
+	case bsontype.Null, bsontype.Undefined:
+		*d = local.Date{}
+	default:
+		return fmt.Errorf("localbson: cannot decode BSON %v into a date", t)
+	}
+	return nil
+}
+
+func unmarshalDateTime(t bsontype.Type, data []byte, dt *local.DateTime) error {
+	switch t {
+	case bsontype.String:
+		s, err := readString(data)
+		if err != nil {
+			return err
+		}
+		dt1, err := local.DateTimeParse(s)
+		if err != nil {
+			return err
+		}
+		*dt = dt1
+	case bsontype.DateTime:
+		msec, err := readDateTime(data)
+		if err != nil {
+			return err
+		}
+		*dt = local.DateTimeFromUnixMilli(msec)
+	case bsontype.Null, bsontype.Undefined:
+		*dt = local.DateTime{}
+	default:
+		return fmt.Errorf("localbson: cannot decode BSON %v into a date-time", t)
+	}
+	return nil
+}
+
+// appendString appends a BSON string: the length including the
+// terminating null as a little-endian int32, the bytes and a null.
+func appendString(b []byte, s string) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s)+1))
+	b = append(b, s...)
+	return append(b, 0)
+}
+
+// readString reads a BSON string, which must be the whole of data.
+func readString(data []byte) (string, error) {
+	if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-4 || data[len(data)-1] != 0 {
+		return "", errInvalidBSON
+	}
+	return string(data[4 : len(data)-1]), nil
+}
+
+// appendDateTime appends a BSON UTC datetime, the number of milliseconds
+// since January 1, 1970 UTC as a little-endian int64.
+func appendDateTime(b []byte, msec int64) []byte {
+	return binary.LittleEndian.AppendUint64(b, uint64(msec))
+}
+
+// readDateTime reads a BSON UTC datetime, which must be the whole of data.
+func readDateTime(data []byte) (int64, error) {
+	if len(data) != 8 {
+		return 0, errInvalidBSON
+	}
+	return int64(binary.LittleEndian.Uint64(data)), nil
+}
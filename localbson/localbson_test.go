@@ -0,0 +1,84 @@
+package localbson
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkg/local"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMarshalBSON(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		D   Date        `bson:"d"`
+		UD  UTCDate     `bson:"ud"`
+		DT  DateTime    `bson:"dt"`
+		UDT UTCDateTime `bson:"udt"`
+	}
+	v := testStruct{
+		D:   Date(local.DateFor(2025, time.September, 30)),
+		UD:  UTCDate(local.DateFor(2025, time.September, 30)),
+		DT:  DateTime(local.DateTimeFor(2025, time.September, 30, 15, 4, 5)),
+		UDT: UTCDateTime(local.DateTimeForNano(2025, time.September, 30, 15, 4, 5, 123000000)),
+	}
+	data, err := bson.Marshal(v)
+	assert.NoError(err)
+
+	expected := []byte{
+		0x4c, 0, 0, 0, // document length
+		0x02, 'd', 0, 11, 0, 0, 0, '2', '0', '2', '5', '-', '0', '9', '-', '3', '0', 0,
+		0x09, 'u', 'd', 0, 0x00, 0x3c, 0xeb, 0x97, 0x99, 0x01, 0, 0, // 1759190400000
+		0x02, 'd', 't', 0, 20, 0, 0, 0, '2', '0', '2', '5', '-', '0', '9', '-', '3', '0',
+		'T', '1', '5', ':', '0', '4', ':', '0', '5', 0,
+		0x09, 'u', 'd', 't', 0, 0x03, 0xf3, 0x26, 0x9b, 0x99, 0x01, 0, 0, // 1759244645123
+		0x00,
+	}
+	assert.Equal(expected, data)
+
+	var v2 testStruct
+	assert.NoError(bson.Unmarshal(data, &v2))
+	assert.Equal(v, v2)
+}
+
+func TestUnmarshalBSON(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		D   Date        `bson:"d"`
+		UD  UTCDate     `bson:"ud"`
+		DT  DateTime    `bson:"dt"`
+		UDT UTCDateTime `bson:"udt"`
+	}
+
+	// each field is stored in the other representation, or is null
+	input := bson.D{
+		{Key: "d", Value: time.Date(2025, 9, 30, 23, 0, 0, 0, time.UTC)},
+		{Key: "ud", Value: "20250930"},
+		{Key: "dt", Value: time.Date(2025, 9, 30, 15, 4, 5, 0, time.UTC)},
+		{Key: "udt", Value: nil},
+	}
+	data, err := bson.Marshal(input)
+	assert.NoError(err)
+	v := testStruct{UDT: UTCDateTime(local.DateTimeFor(2025, time.January, 1, 0, 0, 0))}
+	assert.NoError(bson.Unmarshal(data, &v))
+	assert.Equal(local.DateFor(2025, time.September, 30), local.Date(v.D))
+	assert.Equal(local.DateFor(2025, time.September, 30), local.Date(v.UD))
+	assert.Equal(local.DateTimeFor(2025, time.September, 30, 15, 4, 5), local.DateTime(v.DT))
+	assert.True(local.DateTime(v.UDT).IsZero())
+
+	for _, input := range []bson.D{
+		{{Key: "d", Value: "xxx"}},
+		{{Key: "d", Value: int32(1)}},
+		{{Key: "dt", Value: "2025-09-30T15:04:05Z"}},
+		{{Key: "udt", Value: true}},
+	} {
+		data, err := bson.Marshal(input)
+		assert.NoError(err)
+		assert.Error(bson.Unmarshal(data, &v), "%v", input)
+	}
+
+	var d Date
+	assert.Error(d.UnmarshalBSONValue(0x02, []byte{5, 0, 0, 0, 'a', 'b', 'c', 'd', 0}))
+	assert.Error(d.UnmarshalBSONValue(0x09, []byte{0, 0, 0, 0}))
+}
@@ -0,0 +1,16 @@
+module github.com/spkg/local/localcbor
+
+go 1.20
+
+require (
+	github.com/fxamacker/cbor/v2 v2.9.2
+	github.com/spkg/local v1.0.0
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
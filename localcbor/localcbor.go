@@ -0,0 +1,226 @@
+// Package localcbor encodes local.Date values as CBOR (RFC 8949) using the
+// date tags defined by RFC 8943.
+//
+// The Date type is encoded as tag 1004, an RFC 3339 full-date text string
+// such as "2025-09-30". The Days type is encoded as tag 100, an integer
+// number of days since January 1, 1970. Both types decode either tag.
+//
+// The types implement the Marshaler and Unmarshaler interfaces of the
+// github.com/fxamacker/cbor/v2 package, so they can be used as fields of
+// structs encoded by that package. RegisterTags adds the tags to a
+// cbor.TagSet, so that tagged dates decoded into interface values have
+// these types. A CBOR null or undefined decodes as the zero value, as in
+// the other codec subpackages. Convert between the types and local.Date
+// with a type conversion:
+//
+//	cd := localcbor.Date(d)
+//	d = local.Date(cd)
+package localcbor
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/spkg/local"
+)
+
+// CBOR tags for dates, defined by RFC 8943.
+const (
+	TagDays     = 100  // integer number of days since January 1, 1970
+	TagFullDate = 1004 // RFC 3339 full-date text string
+)
+
+// CBOR major types.
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorText     = 3
+	majorTag      = 6
+)
+
+var (
+	errInvalidCBOR      = errors.New("localcbor: invalid CBOR date")
+	errYearOutOfRange   = errors.New("localcbor: year out of range for full-date")
+	errTrailingData     = errors.New("localcbor: unexpected data after date")
+	errDaysOutOfRange   = errors.New("localcbor: days out of range")
+	errUnsupportedValue = errors.New("localcbor: indefinite length text is not supported")
+)
+
+// Date is a local.Date that is encoded as a CBOR full-date (tag 1004).
+type Date local.Date
+
+// MarshalCBOR returns the CBOR encoding of d as tag 1004. An error
+// is returned if the year is outside the range [0, 9999].
+func (d Date) MarshalCBOR() ([]byte, error) {
+	return AppendFullDate(nil, local.Date(d))
+}
+
+// UnmarshalCBOR decodes a CBOR date with tag 1004 or tag 100, or an
+// untagged full-date text string. A CBOR null or undefined sets d to the
+// zero value.
+func (d *Date) UnmarshalCBOR(data []byte) error {
+	return unmarshal(data, TagFullDate, (*local.Date)(d))
+}
+
+// Days is a local.Date that is encoded as a CBOR number of days
+// since January 1, 1970 (tag 100).
+type Days local.Date
+
+// MarshalCBOR returns the CBOR encoding of d as tag 100.
+func (d Days) MarshalCBOR() ([]byte, error) {
+	return AppendDays(nil, local.Date(d)), nil
+}
+
+// UnmarshalCBOR decodes a CBOR date with tag 100 or tag 1004, or an
+// untagged integer. A CBOR null or undefined sets d to the zero value.
+func (d *Days) UnmarshalCBOR(data []byte) error {
+	return unmarshal(data, TagDays, (*local.Date)(d))
+}
+
+// RegisterTags adds Date as tag 1004 and Days as tag 100 to tags. Encoding
+// and decoding modes created with tags then decode a tagged date into an
+// interface value as a Date or Days, rather than as a cbor.Tag.
+func RegisterTags(tags cbor.TagSet) error {
+	opts := cbor.TagOptions{DecTag: cbor.DecTagOptional, EncTag: cbor.EncTagRequired}
+	if err := tags.Add(opts, reflect.TypeOf(Date{}), TagFullDate); err != nil {
+		return err
+	}
+	return tags.Add(opts, reflect.TypeOf(Days{}), TagDays)
+}
+
+// AppendFullDate appends the CBOR encoding of d as tag 1004 to b. An error
+// is returned if the year is outside the range [0, 9999].
+func AppendFullDate(b []byte, d local.Date) ([]byte, error) {
+	if year := d.Year(); year < 0 || year > 9999 {
+		return b, errYearOutOfRange
+	}
+	s := d.String()
+	b = appendHead(b, majorTag, TagFullDate)
+	b = appendHead(b, majorText, uint64(len(s)))
+	return append(b, s...), nil
+}
+
+// AppendDays appends the CBOR encoding of d as tag 100 to b.
+func AppendDays(b []byte, d local.Date) []byte {
+	b = appendHead(b, majorTag, TagDays)
+	days := int64(d.Days())
+	if days < 0 {
+		return appendHead(b, majorNegative, uint64(-1-days))
+	}
+	return appendHead(b, majorUnsigned, uint64(days))
+}
+
+// ReadDate reads a CBOR date with tag 1004 or tag 100 from the start of b.
+// It returns the date and the number of bytes read.
+func ReadDate(b []byte) (local.Date, int, error) {
+	major, tag, n, err := readHead(b)
+	if err != nil {
+		return local.Date{}, 0, err
+	}
+	if major != majorTag || tag != TagFullDate && tag != TagDays {
+		return local.Date{}, 0, errInvalidCBOR
+	}
+	d, m, err := readContent(b[n:], tag)
+	if err != nil {
+		return local.Date{}, 0, err
+	}
+	return d, n + m, nil
+}
+
+// unmarshal decodes a single CBOR data item into d. An untagged
+// item is decoded as the content of defaultTag.
+func unmarshal(data []byte, defaultTag uint64, d *local.Date) error {
+	if len(data) == 1 && (data[0] == 0xf6 || data[0] == 0xf7) {
+		*d = local.Date{}
+		return nil
+	}
+	var d1 local.Date
+	var n int
+	var err error
+	if len(data) > 0 && int(data[0]>>5) == majorTag {
+		d1, n, err = ReadDate(data)
+	} else {
+		d1, n, err = readContent(data, defaultTag)
+	}
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return errTrailingData
+	}
+	*d = d1
+	return nil
+}
+
+// readContent reads the content of a date with the tag.
+func readContent(b []byte, tag uint64) (local.Date, int, error) {
+	major, arg, n, err := readHead(b)
+	if err != nil {
+		return local.Date{}, 0, err
+	}
+	switch {
+	case tag == TagFullDate && major == majorText:
+		if arg > uint64(len(b)-n) {
+			return local.Date{}, 0, errInvalidCBOR
+		}
+		d, err := local.DateParseRFC3339(string(b[n : n+int(arg)]))
+		if err != nil {
+			return local.Date{}, 0, errInvalidCBOR
+		}
+		return d, n + int(arg), nil
+	case tag == TagDays && (major == majorUnsigned || major == majorNegative):
+		if arg > math.MaxInt32 {
+			return local.Date{}, 0, errDaysOutOfRange
+		}
+		days := int(arg)
+		if major == majorNegative {
+			days = -1 - days
+		}
+		return local.DateFromDays(days), n, nil
+	}
+	return local.Date{}, 0, errInvalidCBOR
+}
+
+// appendHead appends the initial bytes of a CBOR data item.
+func appendHead(b []byte, major int, arg uint64) []byte {
+	m := byte(major << 5)
+	switch {
+	case arg < 24:
+		return append(b, m|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(b, m|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, m|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, m|26), uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64(append(b, m|27), arg)
+}
+
+// readHead reads the initial bytes of a CBOR data item, returning the
+// major type, the argument and the number of bytes read.
+func readHead(b []byte) (major int, arg uint64, n int, err error) {
+	if len(b) == 0 {
+		return 0, 0, 0, errInvalidCBOR
+	}
+	major = int(b[0] >> 5)
+	info := b[0] & 0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), 1, nil
+	case info == 24 && len(b) >= 2:
+		return major, uint64(b[1]), 2, nil
+	case info == 25 && len(b) >= 3:
+		return major, uint64(binary.BigEndian.Uint16(b[1:])), 3, nil
+	case info == 26 && len(b) >= 5:
+		return major, uint64(binary.BigEndian.Uint32(b[1:])), 5, nil
+	case info == 27 && len(b) >= 9:
+		return major, binary.BigEndian.Uint64(b[1:]), 9, nil
+	case info == 31:
+		return 0, 0, 0, errUnsupportedValue
+	}
+	return 0, 0, 0, errInvalidCBOR
+}
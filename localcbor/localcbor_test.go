@@ -0,0 +1,150 @@
+package localcbor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/spkg/local"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFullDate(t *testing.T) {
+	assert := assert.New(t)
+	d := local.DateFor(1940, time.October, 9)
+
+	// example from RFC 8943, section 3
+	expected := []byte{0xd9, 0x03, 0xec, 0x6a, '1', '9', '4', '0', '-', '1', '0', '-', '0', '9'}
+	data, err := Date(d).MarshalCBOR()
+	assert.NoError(err)
+	assert.Equal(expected, data)
+
+	var cd Date
+	assert.NoError(cd.UnmarshalCBOR(data))
+	assert.Equal(d, local.Date(cd))
+
+	d2, n, err := ReadDate(append(data, 0xff))
+	assert.NoError(err)
+	assert.Equal(len(expected), n)
+	assert.Equal(d, d2)
+
+	_, err = Date(local.DateFor(10000, time.January, 1)).MarshalCBOR()
+	assert.Error(err)
+}
+
+func TestDays(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		date local.Date
+		data []byte
+	}{
+		// examples from RFC 8943, section 3
+		{local.DateFor(1940, time.October, 9), []byte{0xd8, 0x64, 0x39, 0x29, 0xb3}},
+		{local.DateFor(1980, time.December, 8), []byte{0xd8, 0x64, 0x19, 0x0f, 0x9a}},
+		{local.DateFor(1970, time.January, 1), []byte{0xd8, 0x64, 0x00}},
+		{local.DateFor(1969, time.December, 31), []byte{0xd8, 0x64, 0x20}},
+	}
+	for _, tc := range testCases {
+		data, err := Days(tc.date).MarshalCBOR()
+		assert.NoError(err)
+		assert.Equal(tc.data, data)
+
+		var d Days
+		assert.NoError(d.UnmarshalCBOR(data))
+		assert.Equal(tc.date, local.Date(d))
+
+		// Date accepts tag 100, and Days accepts tag 1004
+		var cd Date
+		assert.NoError(cd.UnmarshalCBOR(data))
+		assert.Equal(tc.date, local.Date(cd))
+		data, err = Date(tc.date).MarshalCBOR()
+		assert.NoError(err)
+		assert.NoError(d.UnmarshalCBOR(data))
+		assert.Equal(tc.date, local.Date(d))
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	assert := assert.New(t)
+	d := Date(local.DateFor(2025, time.September, 30))
+
+	// null and undefined decode as the zero value
+	assert.NoError(d.UnmarshalCBOR([]byte{0xf6}))
+	assert.Equal(local.Date{}, local.Date(d))
+	d = Date(local.DateFor(2025, time.September, 30))
+	assert.NoError(d.UnmarshalCBOR([]byte{0xf7}))
+	assert.Equal(local.Date{}, local.Date(d))
+
+	// untagged values
+	assert.NoError(d.UnmarshalCBOR([]byte{0x6a, '2', '0', '2', '5', '-', '1', '0', '-', '0', '1'}))
+	assert.Equal(local.DateFor(2025, time.October, 1), local.Date(d))
+	var days Days
+	assert.NoError(days.UnmarshalCBOR([]byte{0x01}))
+	assert.Equal(local.DateFor(1970, time.January, 2), local.Date(days))
+
+	for _, data := range [][]byte{
+		{},
+		{0x01},                   // untagged integer for Date
+		{0xd9, 0x03, 0xec, 0x01}, // tag 1004 with integer
+		{0xd8, 0x64, 0x61, 'x'},  // tag 100 with text
+		{0xd8, 0x65, 0x00},       // tag 101
+		{0xd9, 0x03, 0xec, 0x6a, '2', '0', '2', '5'}, // short text
+		{0xd9, 0x03, 0xec, 0x64, '2', '0', '2', '5'}, // invalid date
+		{0xd8, 0x64, 0x00, 0x00},                     // trailing data
+		{0xd8, 0x64, 0x1b, 0, 0, 0, 1, 0, 0, 0, 0},   // out of range
+		{0xd9, 0x03, 0xec, 0x7f, 0xff},               // indefinite length
+	} {
+		assert.Error(d.UnmarshalCBOR(data), "%x", data)
+	}
+}
+
+func TestFxamackerCBOR(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		Born  Date  `cbor:"1,keyasint"`
+		Added Days  `cbor:"2,keyasint"`
+		Ptr   *Date `cbor:"3,keyasint,omitempty"`
+	}
+	v := testStruct{
+		Born:  Date(local.DateFor(1940, time.October, 9)),
+		Added: Days(local.DateFor(1980, time.December, 8)),
+	}
+	data, err := cbor.Marshal(v)
+	assert.NoError(err)
+	assert.Equal([]byte{0xa2,
+		0x01, 0xd9, 0x03, 0xec, 0x6a, '1', '9', '4', '0', '-', '1', '0', '-', '0', '9',
+		0x02, 0xd8, 0x64, 0x19, 0x0f, 0x9a}, data)
+
+	var v2 testStruct
+	assert.NoError(cbor.Unmarshal(data, &v2))
+	assert.Equal(v, v2)
+}
+
+func TestRegisterTags(t *testing.T) {
+	assert := assert.New(t)
+	tags := cbor.NewTagSet()
+	assert.NoError(RegisterTags(tags))
+	em, err := cbor.EncOptions{}.EncModeWithTags(tags)
+	assert.NoError(err)
+	dm, err := cbor.DecOptions{}.DecModeWithTags(tags)
+	assert.NoError(err)
+
+	born := Date(local.DateFor(1940, time.October, 9))
+	added := Days(local.DateFor(1980, time.December, 8))
+	data, err := em.Marshal([]interface{}{born, added})
+	assert.NoError(err)
+	assert.Equal([]byte{0x82,
+		0xd9, 0x03, 0xec, 0x6a, '1', '9', '4', '0', '-', '1', '0', '-', '0', '9',
+		0xd8, 0x64, 0x19, 0x0f, 0x9a}, data)
+
+	var v []interface{}
+	assert.NoError(dm.Unmarshal(data, &v))
+	assert.Equal([]interface{}{born, added}, v)
+
+	// without the tag set, tagged dates decode as cbor.Tag
+	assert.NoError(cbor.Unmarshal(data, &v))
+	assert.Equal(cbor.Tag{Number: TagFullDate, Content: "1940-10-09"}, v[0])
+
+	// a tag number can only be registered once
+	assert.Error(RegisterTags(tags))
+}
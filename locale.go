@@ -0,0 +1,363 @@
+package local
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var errUnknownLocale = errors.New("unknown locale")
+
+// FormatStyle specifies the length of a locale-specific date or time format.
+type FormatStyle int
+
+// Format styles, from the longest to the shortest.
+const (
+	FullStyle   FormatStyle = iota // Tuesday, September 30, 2025
+	LongStyle                      // September 30, 2025
+	MediumStyle                    // Sep 30, 2025
+	ShortStyle                     // 9/30/25
+)
+
+// valid returns s, or MediumStyle if s is not one of the format styles.
+func (s FormatStyle) valid() FormatStyle {
+	if s < FullStyle || s > ShortStyle {
+		return MediumStyle
+	}
+	return s
+}
+
+// Locale contains the data needed to format local dates and date-times
+// for a particular language and region. The locale data is compiled into
+// the package, so no external data files are required.
+//
+// Use LocaleFor to obtain a Locale.
+type Locale struct {
+	tag           string
+	months        [12]string
+	shortMonths   [12]string
+	narrowMonths  [12]string
+	days          [7]string
+	shortDays     [7]string
+	narrowDays    [7]string
+	eras          [2]string
+	wideEras      [2]string
+	quarters      [4]string
+	shortQuarters [4]string
+	dayPeriods    [2]string
+	dateFormats   [4]string
+	timeFormats   [4]string
+	joinFormats   [4]string
+	digits        string
+	ordinal       func(n int) string
+}
+
+// LocaleFor returns the Locale for a BCP 47 language tag, such as "fr"
+// or "en-GB". If there is no locale data for the region, the data for
+// the language is used. An error is returned if there is no locale data
+// for the language.
+//
+// Locale data is available for the following tags: en, en-US, en-GB,
+// fr, de, ja and ar.
+func LocaleFor(tag string) (*Locale, error) {
+	tag = strings.Replace(strings.TrimSpace(tag), "_", "-", -1)
+	for {
+		for _, l := range locales {
+			if strings.EqualFold(l.tag, tag) {
+				return l, nil
+			}
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			return nil, errUnknownLocale
+		}
+		tag = tag[:i]
+	}
+}
+
+// Tag returns the BCP 47 language tag of the locale.
+func (l *Locale) Tag() string {
+	return l.tag
+}
+
+// FormatDate returns a textual representation of d using the
+// locale's date format for style. An unknown style is treated as MediumStyle.
+func (l *Locale) FormatDate(d Date, style FormatStyle) string {
+	style = style.valid()
+	return l.FormatDatePattern(d, l.dateFormats[style])
+}
+
+// FormatDateTime returns a textual representation of dt using the
+// locale's date and time formats for style. An unknown style is treated
+// as MediumStyle.
+func (l *Locale) FormatDateTime(dt DateTime, style FormatStyle) string {
+	style = style.valid()
+	pattern := l.joinFormats[style]
+	pattern = strings.Replace(pattern, "{1}", l.dateFormats[style], 1)
+	pattern = strings.Replace(pattern, "{0}", l.timeFormats[style], 1)
+	return l.FormatDateTimePattern(dt, pattern)
+}
+
+// FormatDatePattern returns a textual representation of d formatted
+// according to pattern, which uses the Unicode LDML date format syntax
+// (for example "EEEE d MMMM y"). Month, weekday, era and day period names
+// are taken from the locale. Time fields in the pattern are formatted as
+// midnight.
+//
+// See Date.FormatPattern for the supported fields. The letter "o" formats
+// the day of the month as an ordinal number, such as "1st" in English,
+// "1er" in French or "1." in German.
+func (l *Locale) FormatDatePattern(d Date, pattern string) string {
+	year, month, day := d.Date()
+	return l.formatPattern(pattern, DateTimeFor(year, month, day, 0, 0, 0))
+}
+
+// FormatDateTimePattern returns a textual representation of dt formatted
+// according to pattern, which uses the Unicode LDML date format syntax
+// (for example "d MMM y HH:mm"). See FormatDatePattern for details.
+func (l *Locale) FormatDateTimePattern(dt DateTime, pattern string) string {
+	return l.formatPattern(pattern, dt)
+}
+
+// ParseDatePattern parses value according to pattern, which uses the
+// Unicode LDML date format syntax, and returns the date it represents.
+// Names and digits are matched using the locale. See DateParsePattern
+// for details.
+func (l *Locale) ParseDatePattern(pattern, value string) (Date, error) {
+	dt, err := l.parsePattern(pattern, value)
+	if err != nil {
+		return Date{}, err
+	}
+	year, month, day := dt.Date()
+	return DateFor(year, month, day), nil
+}
+
+// ParseDateTimePattern parses value according to pattern, which uses the
+// Unicode LDML date format syntax, and returns the date-time it represents.
+// Names and digits are matched using the locale. See DateParsePattern
+// for details.
+func (l *Locale) ParseDateTimePattern(pattern, value string) (DateTime, error) {
+	return l.parsePattern(pattern, value)
+}
+
+// Ordinal returns n as an ordinal number in the locale's language,
+// such as "2nd" in English.
+func (l *Locale) Ordinal(n int) string {
+	if l.ordinal == nil {
+		return l.number(n, 1)
+	}
+	return l.localizeDigits(l.ordinal(n))
+}
+
+// number returns n formatted with at least width digits,
+// using the locale's digits.
+func (l *Locale) number(n int, width int) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return sign + l.localizeDigits(s)
+}
+
+// localizeDigits replaces the ASCII digits in s with the locale's digits.
+func (l *Locale) localizeDigits(s string) string {
+	if l.digits == "" {
+		return s
+	}
+	digits := []rune(l.digits)
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			r = digits[r-'0']
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func englishOrdinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+var localeEnglish = &Locale{
+	tag: "en",
+	months: [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	},
+	shortMonths: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	narrowMonths:  [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	days:          [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	shortDays:     [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	narrowDays:    [7]string{"S", "M", "T", "W", "T", "F", "S"},
+	eras:          [2]string{"BC", "AD"},
+	wideEras:      [2]string{"Before Christ", "Anno Domini"},
+	quarters:      [4]string{"1st quarter", "2nd quarter", "3rd quarter", "4th quarter"},
+	shortQuarters: [4]string{"Q1", "Q2", "Q3", "Q4"},
+	dayPeriods:    [2]string{"AM", "PM"},
+	dateFormats:   [4]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+	timeFormats:   [4]string{"h:mm:ss a", "h:mm:ss a", "h:mm:ss a", "h:mm a"},
+	joinFormats:   [4]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+	ordinal:       englishOrdinal,
+}
+
+var localeBritishEnglish = &Locale{
+	tag:    "en-GB",
+	months: localeEnglish.months,
+	shortMonths: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sept", "Oct", "Nov", "Dec",
+	},
+	narrowMonths:  localeEnglish.narrowMonths,
+	days:          localeEnglish.days,
+	shortDays:     localeEnglish.shortDays,
+	narrowDays:    localeEnglish.narrowDays,
+	eras:          localeEnglish.eras,
+	wideEras:      localeEnglish.wideEras,
+	quarters:      localeEnglish.quarters,
+	shortQuarters: localeEnglish.shortQuarters,
+	dayPeriods:    [2]string{"am", "pm"},
+	dateFormats:   [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+	timeFormats:   [4]string{"HH:mm:ss", "HH:mm:ss", "HH:mm:ss", "HH:mm"},
+	joinFormats:   localeEnglish.joinFormats,
+	ordinal:       englishOrdinal,
+}
+
+var localeFrench = &Locale{
+	tag: "fr",
+	months: [12]string{
+		"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	shortMonths: [12]string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	narrowMonths:  [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	days:          [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	shortDays:     [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	narrowDays:    [7]string{"D", "L", "M", "M", "J", "V", "S"},
+	eras:          [2]string{"av. J.-C.", "ap. J.-C."},
+	wideEras:      [2]string{"avant Jésus-Christ", "après Jésus-Christ"},
+	quarters:      [4]string{"1er trimestre", "2e trimestre", "3e trimestre", "4e trimestre"},
+	shortQuarters: [4]string{"T1", "T2", "T3", "T4"},
+	dayPeriods:    [2]string{"AM", "PM"},
+	dateFormats:   [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+	timeFormats:   [4]string{"HH:mm:ss", "HH:mm:ss", "HH:mm:ss", "HH:mm"},
+	joinFormats:   [4]string{"{1} 'à' {0}", "{1} 'à' {0}", "{1}, {0}", "{1} {0}"},
+	ordinal: func(n int) string {
+		if n == 1 {
+			return "1er"
+		}
+		return strconv.Itoa(n)
+	},
+}
+
+var localeGerman = &Locale{
+	tag: "de",
+	months: [12]string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	},
+	shortMonths: [12]string{
+		"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+		"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez.",
+	},
+	narrowMonths:  [12]string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+	days:          [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	shortDays:     [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	narrowDays:    [7]string{"S", "M", "D", "M", "D", "F", "S"},
+	eras:          [2]string{"v. Chr.", "n. Chr."},
+	wideEras:      [2]string{"v. Chr.", "n. Chr."},
+	quarters:      [4]string{"1. Quartal", "2. Quartal", "3. Quartal", "4. Quartal"},
+	shortQuarters: [4]string{"Q1", "Q2", "Q3", "Q4"},
+	dayPeriods:    [2]string{"AM", "PM"},
+	dateFormats:   [4]string{"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy"},
+	timeFormats:   [4]string{"HH:mm:ss", "HH:mm:ss", "HH:mm:ss", "HH:mm"},
+	joinFormats:   [4]string{"{1} 'um' {0}", "{1} 'um' {0}", "{1}, {0}", "{1}, {0}"},
+	ordinal: func(n int) string {
+		return strconv.Itoa(n) + "."
+	},
+}
+
+var localeJapanese = &Locale{
+	tag: "ja",
+	months: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	shortMonths: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	narrowMonths:  [12]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+	days:          [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	shortDays:     [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	narrowDays:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	eras:          [2]string{"紀元前", "西暦"},
+	wideEras:      [2]string{"紀元前", "西暦"},
+	quarters:      [4]string{"第1四半期", "第2四半期", "第3四半期", "第4四半期"},
+	shortQuarters: [4]string{"Q1", "Q2", "Q3", "Q4"},
+	dayPeriods:    [2]string{"午前", "午後"},
+	dateFormats:   [4]string{"y年M月d日EEEE", "y年M月d日", "y/MM/dd", "y/MM/dd"},
+	timeFormats:   [4]string{"H時mm分ss秒", "H:mm:ss", "H:mm:ss", "H:mm"},
+	joinFormats:   [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+	ordinal: func(n int) string {
+		return strconv.Itoa(n) + "日"
+	},
+}
+
+var localeArabic = &Locale{
+	tag: "ar",
+	months: [12]string{
+		"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو",
+		"يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر",
+	},
+	shortMonths: [12]string{
+		"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو",
+		"يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر",
+	},
+	narrowMonths:  [12]string{"ي", "ف", "م", "أ", "و", "ن", "ل", "غ", "س", "ك", "ب", "د"},
+	days:          [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+	shortDays:     [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+	narrowDays:    [7]string{"ح", "ن", "ث", "ر", "خ", "ج", "س"},
+	eras:          [2]string{"ق.م", "م"},
+	wideEras:      [2]string{"قبل الميلاد", "ميلادي"},
+	quarters:      [4]string{"الربع الأول", "الربع الثاني", "الربع الثالث", "الربع الرابع"},
+	shortQuarters: [4]string{"الربع الأول", "الربع الثاني", "الربع الثالث", "الربع الرابع"},
+	dayPeriods:    [2]string{"ص", "م"},
+	dateFormats:   [4]string{"EEEE، d MMMM y", "d MMMM y", "dd‏/MM‏/y", "d‏/M‏/y"},
+	timeFormats:   [4]string{"h:mm:ss a", "h:mm:ss a", "h:mm:ss a", "h:mm a"},
+	joinFormats:   [4]string{"{1} في {0}", "{1} في {0}", "{1}، {0}", "{1}، {0}"},
+	digits:        "٠١٢٣٤٥٦٧٨٩",
+}
+
+// locales contains all of the locale data compiled into the package.
+var locales = []*Locale{
+	localeEnglish,
+	localeBritishEnglish,
+	localeFrench,
+	localeGerman,
+	localeJapanese,
+	localeArabic,
+}
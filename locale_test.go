@@ -0,0 +1,224 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleFor(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Tag      string
+		Expected string
+		Error    bool
+	}{
+		{Tag: "en", Expected: "en"},
+		{Tag: "en-US", Expected: "en"},
+		{Tag: "en-GB", Expected: "en-GB"},
+		{Tag: "en_gb", Expected: "en-GB"},
+		{Tag: "fr-CA", Expected: "fr"},
+		{Tag: "de-DE", Expected: "de"},
+		{Tag: "ja-JP", Expected: "ja"},
+		{Tag: "ar-EG", Expected: "ar"},
+		{Tag: "zz", Error: true},
+		{Tag: "", Error: true},
+	}
+
+	for _, tc := range testCases {
+		l, err := LocaleFor(tc.Tag)
+		if tc.Error {
+			assert.Error(err, tc.Tag)
+		} else {
+			assert.NoError(err, tc.Tag)
+			assert.Equal(tc.Expected, l.Tag(), tc.Tag)
+		}
+	}
+}
+
+func mustLocale(tag string) *Locale {
+	l, err := LocaleFor(tag)
+	if err != nil {
+		panic(err.Error())
+	}
+	return l
+}
+
+func TestLocaleFormatDate(t *testing.T) {
+	assert := assert.New(t)
+	d := DateFor(2025, 9, 30)
+	testCases := []struct {
+		Tag      string
+		Style    FormatStyle
+		Expected string
+	}{
+		{"en", FullStyle, "Tuesday, September 30, 2025"},
+		{"en", LongStyle, "September 30, 2025"},
+		{"en", MediumStyle, "Sep 30, 2025"},
+		{"en", ShortStyle, "9/30/25"},
+		{"en-GB", FullStyle, "Tuesday 30 September 2025"},
+		{"en-GB", ShortStyle, "30/09/2025"},
+		{"fr", FullStyle, "mardi 30 septembre 2025"},
+		{"fr", MediumStyle, "30 sept. 2025"},
+		{"de", FullStyle, "Dienstag, 30. September 2025"},
+		{"de", ShortStyle, "30.09.25"},
+		{"ja", FullStyle, "2025年9月30日火曜日"},
+		{"ja", ShortStyle, "2025/09/30"},
+		{"ar", LongStyle, "٣٠ سبتمبر ٢٠٢٥"},
+		{"en", FormatStyle(7), "Sep 30, 2025"},
+		{"en", FormatStyle(-1), "Sep 30, 2025"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, mustLocale(tc.Tag).FormatDate(d, tc.Style), tc.Tag)
+	}
+}
+
+func TestLocaleFormatDateTime(t *testing.T) {
+	assert := assert.New(t)
+	dt := DateTimeFor(2025, 9, 30, 15, 4, 5)
+	testCases := []struct {
+		Tag      string
+		Style    FormatStyle
+		Expected string
+	}{
+		{"en", FullStyle, "Tuesday, September 30, 2025 at 3:04:05 PM"},
+		{"en", ShortStyle, "9/30/25, 3:04 PM"},
+		{"en-GB", MediumStyle, "30 Sept 2025, 15:04:05"},
+		{"fr", LongStyle, "30 septembre 2025 à 15:04:05"},
+		{"de", MediumStyle, "30.09.2025, 15:04:05"},
+		{"ja", FullStyle, "2025年9月30日火曜日 15時04分05秒"},
+		{"ar", ShortStyle, "٣٠‏/٩‏/٢٠٢٥، ٣:٠٤ م"},
+		{"de", FormatStyle(7), "30.09.2025, 15:04:05"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, mustLocale(tc.Tag).FormatDateTime(dt, tc.Style), tc.Tag)
+	}
+}
+
+func TestLocaleFormatPattern(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Tag      string
+		DateTime DateTime
+		Pattern  string
+		Expected string
+	}{
+		{"en", DateTimeFor(2025, 9, 1, 0, 7, 0), "EEEE 'the' o 'of' MMMM", "Monday the 1st of September"},
+		{"en", DateTimeFor(2025, 9, 12, 0, 7, 0), "o MMM", "12th Sep"},
+		{"en", DateTimeFor(2025, 9, 23, 0, 7, 0), "o MMM", "23rd Sep"},
+		{"en", DateTimeFor(2025, 9, 23, 0, 7, 0), "h:mm a, hh 'o''clock', k, K", "12:07 AM, 12 o'clock, 24, 0"},
+		{"en", DateTimeForNano(2025, 9, 23, 13, 7, 8, 123456789), "HH:mm:ss.SSS", "13:07:08.123"},
+		{"en", DateTimeFor(2025, 9, 23, 0, 0, 0), "EEEEE MMMMM yy D", "T S 25 266"},
+		{"en", DateTimeFor(0, 3, 1, 0, 0, 0), "y G", "1 BC"},
+		{"en", DateTimeFor(-43, 3, 15, 0, 0, 0), "d MMM y G", "15 Mar 44 BC"},
+		{"fr", DateTimeFor(2025, 9, 1, 0, 0, 0), "EEE o MMMM", "lun. 1er septembre"},
+		{"de", DateTimeFor(2025, 9, 2, 0, 0, 0), "EEEE, 'den' o MMMM", "Dienstag, den 2. September"},
+		{"ja", DateTimeFor(2025, 9, 2, 9, 30, 0), "M月d日(E) aK:mm", "9月2日(火) 午前9:30"},
+		{"ar", DateTimeFor(2025, 9, 2, 0, 0, 0), "EEEE o", "الثلاثاء ٢"},
+	}
+
+	for _, tc := range testCases {
+		l := mustLocale(tc.Tag)
+		assert.Equal(tc.Expected, l.FormatDateTimePattern(tc.DateTime, tc.Pattern), tc.Pattern)
+		if tc.DateTime.Hour() == 0 && tc.DateTime.Minute() == 0 {
+			year, month, day := tc.DateTime.Date()
+			assert.Equal(tc.Expected, l.FormatDatePattern(DateFor(year, month, day), tc.Pattern), tc.Pattern)
+		}
+	}
+}
+
+func TestLocaleParseDate(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Tag        string
+		Text       string
+		Expected   Date
+		Error      bool
+		Candidates []Date
+	}{
+		{Tag: "en-GB", Text: "03/04/2025", Expected: DateFor(2025, 4, 3)},
+		{Tag: "en-US", Text: "03/04/2025", Expected: DateFor(2025, 3, 4)},
+		{Tag: "en-US", Text: "3-4-25", Expected: DateFor(2025, 3, 4)},
+		{Tag: "en-US", Text: "2025/03/04", Expected: DateFor(2025, 3, 4)},
+		{Tag: "en-GB", Text: "2025-03-04", Expected: DateFor(2025, 3, 4)},
+		{Tag: "en", Text: "September 30, 2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "en", Text: "Tue, Sep 30th 2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "en-GB", Text: "1st sept 2025", Expected: DateFor(2025, 9, 1)},
+		{Tag: "en-GB", Text: "30 SEPTEMBER 2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "fr", Text: "1er septembre 2025", Expected: DateFor(2025, 9, 1)},
+		{Tag: "fr", Text: "mardi 30 sept. 2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "fr", Text: "30/09/2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "de", Text: "30.09.2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "de", Text: "Dienstag, 30. September 2025", Expected: DateFor(2025, 9, 30)},
+		{Tag: "de", Text: "3. März 2025", Expected: DateFor(2025, 3, 3)},
+		{Tag: "ja", Text: "2025年9月30日", Expected: DateFor(2025, 9, 30)},
+		{Tag: "ja", Text: "2025年9月30日(火)", Expected: DateFor(2025, 9, 30)},
+		{Tag: "ja", Text: "2025年9月30日火曜日", Expected: DateFor(2025, 9, 30)},
+		{Tag: "ja", Text: "9月30日 2025年", Expected: DateFor(2025, 9, 30)},
+		{Tag: "ja", Text: "2025/09/30", Expected: DateFor(2025, 9, 30)},
+		{Tag: "ar", Text: "٣٠‏/٩‏/٢٠٢٥", Expected: DateFor(2025, 9, 30)},
+		{Tag: "ar", Text: "٣٠ سبتمبر ٢٠٢٥", Expected: DateFor(2025, 9, 30)},
+		{
+			Tag:        "en-US",
+			Text:       "13/01/2025",
+			Error:      true,
+			Candidates: []Date{DateFor(2025, 1, 13)},
+		},
+		{
+			Tag:        "en-GB",
+			Text:       "01/13/2025",
+			Error:      true,
+			Candidates: []Date{DateFor(2025, 1, 13)},
+		},
+		{Tag: "en-GB", Text: "32/13/2025", Error: true},
+		{Tag: "en-GB", Text: "31 February 2025", Error: true},
+		{Tag: "en-GB", Text: "30/09", Error: true},
+		{Tag: "en-GB", Text: "30/09/2025/01", Error: true},
+		{Tag: "en-GB", Text: "30 septembre 2025", Error: true},
+		{Tag: "en-GB", Text: "September October 2025", Error: true},
+		{Tag: "ja", Text: "2025年9月30年", Error: true},
+		{Tag: "ja", Text: "2025年9月30日火曜", Error: true},
+	}
+
+	for _, tc := range testCases {
+		d, err := mustLocale(tc.Tag).ParseDate(tc.Text)
+		if !tc.Error {
+			assert.NoError(err, tc.Text)
+			assert.Equal(tc.Expected, d, datesNotEqual(tc.Expected, d))
+			continue
+		}
+		assert.Error(err, tc.Text)
+		if tc.Candidates == nil {
+			_, ok := err.(*AmbiguousDateError)
+			assert.False(ok, tc.Text)
+			continue
+		}
+		if ambiguous, ok := err.(*AmbiguousDateError); assert.True(ok, tc.Text) {
+			assert.Equal(tc.Candidates, ambiguous.Candidates, tc.Text)
+			assert.Contains(ambiguous.Error(), "2025-01-13")
+		}
+	}
+}
+
+func TestLocaleParseFormattedDate(t *testing.T) {
+	assert := assert.New(t)
+	dates := []Date{
+		DateFor(2025, 9, 30),
+		DateFor(2026, 1, 5),
+		DateFor(2024, 12, 1),
+		DateFor(2023, 5, 22),
+	}
+	for _, l := range locales {
+		for style := FullStyle; style <= ShortStyle; style++ {
+			for _, d := range dates {
+				s := l.FormatDate(d, style)
+				d2, err := l.ParseDate(s)
+				if assert.NoError(err, "%s %q", l.tag, s) {
+					assert.Equal(d, d2, "%s %q", l.tag, s)
+				}
+			}
+		}
+	}
+}
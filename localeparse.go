@@ -0,0 +1,308 @@
+package local
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// AmbiguousDateError is returned by Locale.ParseDate when the numbers in
+// the text do not form a valid date in the locale's field order, but do
+// form a valid date when read in a different order. This usually means that
+// the text was entered using the conventions of a different locale, for
+// example "13/01/2025" parsed using a locale that expects month/day/year.
+type AmbiguousDateError struct {
+	Text       string // text being parsed
+	Locale     string // tag of the locale used to parse the text
+	Candidates []Date // dates that the text could represent in other field orders
+}
+
+// Error implements the error interface.
+func (e *AmbiguousDateError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, d := range e.Candidates {
+		candidates[i] = d.String()
+	}
+	return fmt.Sprintf("ambiguous date %q for locale %s: could be %s",
+		e.Text, e.Locale, strings.Join(candidates, " or "))
+}
+
+// add adds d to the candidates if it is not already present.
+func (e *AmbiguousDateError) add(d Date) {
+	for _, candidate := range e.Candidates {
+		if candidate.Equal(d) {
+			return
+		}
+	}
+	e.Candidates = append(e.Candidates, d)
+}
+
+// dateToken is a number or a word in text entered by a user.
+type dateToken struct {
+	word   string
+	number int
+	digits int
+	field  byte // 'y', 'm' or 'd' if known from the text, otherwise zero
+}
+
+// ParseDate parses a date entered by a user, with the year, month and day
+// in the order used by the locale. For example, "03/04/2025" is 3 April 2025
+// in the "en-GB" locale, and 4 March 2025 in the "en-US" locale.
+//
+// Month names may be used instead of month numbers, in which case the day and
+// year may appear in either order. Weekday names are permitted and ignored.
+// The day may be written as an ordinal, such as "1st" or "1er". Text that starts
+// with a four digit year is always read as year, month, day. In locales such as
+// "ja" that mark the year, month and day with suffixes (2025年9月30日) the
+// suffixes determine the fields.
+//
+// A two digit year is interpreted as being within 80 years before and
+// 20 years after the current year.
+//
+// If the text can only be read as a valid date using a different field order
+// than the locale's, an *AmbiguousDateError is returned.
+func (l *Locale) ParseDate(s string) (Date, error) {
+	tokens, err := l.tokenizeDate(s)
+	if err != nil {
+		return Date{}, err
+	}
+
+	var month int
+	var numbers []dateToken
+	for _, token := range tokens {
+		switch {
+		case token.word == "":
+			numbers = append(numbers, token)
+		case l.lookupName(token.word, l.months[:], l.shortMonths[:]) > 0:
+			if month > 0 {
+				return Date{}, errInvalidDateFormat
+			}
+			month = l.lookupName(token.word, l.months[:], l.shortMonths[:])
+		case l.lookupName(token.word, l.days[:], l.shortDays[:]) > 0:
+			// weekday names are ignored
+		default:
+			return Date{}, errInvalidDateFormat
+		}
+	}
+
+	fields := map[byte]dateToken{}
+	if month > 0 {
+		fields['m'] = dateToken{number: month, field: 'm'}
+	}
+	var unknown []dateToken
+	for i := range numbers {
+		if numbers[i].field == 0 && numbers[i].digits > 2 {
+			numbers[i].field = 'y'
+		}
+	}
+	for _, number := range numbers {
+		if number.field == 0 {
+			unknown = append(unknown, number)
+			continue
+		}
+		if _, ok := fields[number.field]; ok {
+			return Date{}, errInvalidDateFormat
+		}
+		fields[number.field] = number
+	}
+
+	order := l.dateOrder()
+	if len(numbers) > 0 && numbers[0].field == 'y' && month == 0 {
+		order = "ymd"
+	}
+	var missing []byte
+	for i := 0; i < len(order); i++ {
+		if _, ok := fields[order[i]]; !ok {
+			missing = append(missing, order[i])
+		}
+	}
+	if len(missing) != len(unknown) {
+		return Date{}, errInvalidDateFormat
+	}
+	for i, field := range missing {
+		unknown[i].field = field
+		fields[field] = unknown[i]
+	}
+
+	year := l.expandYear(fields['y'])
+	if isValidDate(year, fields['m'].number, fields['d'].number) {
+		return DateFor(year, time.Month(fields['m'].number), fields['d'].number), nil
+	}
+
+	// check for a valid date when the fields that were not
+	// identified by the text are read in a different order
+	if len(unknown) < 2 {
+		return Date{}, errInvalidDateFormat
+	}
+	ambiguous := &AmbiguousDateError{Text: s, Locale: l.tag}
+	for _, alternative := range []string{"ymd", "dmy", "mdy"} {
+		var alt []byte
+		for i := 0; i < len(alternative); i++ {
+			for _, field := range missing {
+				if field == alternative[i] {
+					alt = append(alt, field)
+				}
+			}
+		}
+		for i, field := range alt {
+			fields[field] = unknown[i]
+		}
+		year := l.expandYear(fields['y'])
+		if isValidDate(year, fields['m'].number, fields['d'].number) {
+			ambiguous.add(DateFor(year, time.Month(fields['m'].number), fields['d'].number))
+		}
+	}
+	if len(ambiguous.Candidates) == 0 {
+		return Date{}, errInvalidDateFormat
+	}
+	return Date{}, ambiguous
+}
+
+// tokenizeDate splits user-entered text into numbers and words. Numbers
+// followed by the locale's year, month or day suffix (for example 年, 月 and 日)
+// or by an ordinal suffix are marked with the field they represent.
+func (l *Locale) tokenizeDate(s string) ([]dateToken, error) {
+	var tokens []dateToken
+	runes := []rune(strings.ToLower(l.delocalizeDigits(s)))
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r >= '0' && r <= '9':
+			j := i
+			for j < len(runes) && runes[j] >= '0' && runes[j] <= '9' {
+				j++
+			}
+			n, err := strconv.Atoi(string(runes[i:j]))
+			if err != nil {
+				return nil, errInvalidDateFormat
+			}
+			tokens = append(tokens, dateToken{number: n, digits: j - i})
+			i = j
+		case unicode.IsLetter(r):
+			j := i
+			for j < len(runes) && unicode.IsLetter(runes[j]) {
+				j++
+			}
+			for _, word := range l.splitWord(runes[i:j]) {
+				if n := len(tokens); n > 0 && tokens[n-1].word == "" && tokens[n-1].field == 0 {
+					if field := dateSuffixes[word]; field != 0 {
+						tokens[n-1].field = field
+						continue
+					}
+				}
+				tokens = append(tokens, dateToken{word: word})
+			}
+			i = j
+		default:
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// splitWord splits a run of letters into date suffixes and month and
+// weekday names, which are not separated by spaces in languages such as
+// Japanese (2025年9月30日火曜日). If the run is not made up entirely of
+// these words, it is returned as a single word.
+func (l *Locale) splitWord(run []rune) []string {
+	word := string(run)
+	if l.isDateWord(word) {
+		return []string{word}
+	}
+	for n := len(run) - 1; n > 0; n-- {
+		if !l.isDateWord(string(run[:n])) {
+			continue
+		}
+		if rest := l.splitWord(run[n:]); len(rest) > 1 || l.isDateWord(rest[0]) {
+			return append([]string{string(run[:n])}, rest...)
+		}
+	}
+	return []string{word}
+}
+
+// isDateWord reports whether word is a date suffix or a month or weekday name.
+func (l *Locale) isDateWord(word string) bool {
+	return dateSuffixes[word] != 0 ||
+		l.lookupName(word, l.months[:], l.shortMonths[:], l.days[:], l.shortDays[:]) > 0
+}
+
+// dateSuffixes maps words that can follow a number to the field that
+// the number represents.
+var dateSuffixes = map[string]byte{
+	"年":  'y',
+	"月":  'm',
+	"日":  'd',
+	"st": 'd',
+	"nd": 'd',
+	"rd": 'd',
+	"th": 'd',
+	"er": 'd',
+}
+
+// lookupName returns the one-based index of word in any of the lists
+// of names, ignoring case and trailing full stops. It returns zero if
+// word is not found.
+func (l *Locale) lookupName(word string, lists ...[]string) int {
+	for _, names := range lists {
+		for i, name := range names {
+			name = strings.ToLower(strings.TrimRight(name, "."))
+			if name == word {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
+
+// dateOrder returns the order of the year, month and day fields in
+// the locale's short date format, for example "mdy".
+func (l *Locale) dateOrder() string {
+	var order []byte
+	for _, token := range tokenizePattern(l.dateFormats[ShortStyle]) {
+		switch token.field {
+		case 'y':
+			order = append(order, 'y')
+		case 'M', 'L':
+			order = append(order, 'm')
+		case 'd':
+			order = append(order, 'd')
+		}
+	}
+	return string(order)
+}
+
+// expandYear returns the year represented by a token. Years with
+// one or two digits are interpreted as being within 80 years before
+// and 20 years after the current year.
+func (l *Locale) expandYear(token dateToken) int {
+	if token.digits > 2 {
+		return token.number
+	}
+	thisYear := Today().Year()
+	year := thisYear - thisYear%100 + token.number
+	if year >= thisYear+20 {
+		year -= 100
+	} else if year < thisYear-80 {
+		year += 100
+	}
+	return year
+}
+
+// delocalizeDigits replaces the locale's digits in s with ASCII digits,
+// and removes bidirectional formatting marks.
+func (l *Locale) delocalizeDigits(s string) string {
+	digits := []rune(l.digits)
+	return strings.Map(func(r rune) rune {
+		for i, digit := range digits {
+			if r == digit {
+				return '0' + rune(i)
+			}
+		}
+		if r == '\u200e' || r == '\u200f' {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,78 @@
+// Package localmsgpack registers MessagePack extension types for local.Date
+// and local.DateTime with the github.com/vmihailenco/msgpack/v5 package.
+//
+// MessagePack has no standard types for dates or local date-times, so
+// each application chooses its own extension type numbers, in the range
+// 0 to 127, and passes them to Register. The payload of the extension type
+// is the encoding produced by the MarshalBinary method of the value.
+// A MessagePack nil decodes as the zero value, as in the other codec
+// subpackages.
+package localmsgpack
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/spkg/local"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Register registers local.Date and local.DateTime with the msgpack package,
+// using the extension type numbers dateExtID and dateTimeExtID. Once registered,
+// values of these types, and pointers to them, are encoded as extension types
+// rather than as empty maps.
+//
+// Register is intended to be called once during program initialization.
+func Register(dateExtID, dateTimeExtID int8) {
+	registerBinary(dateExtID, local.Date{})
+	registerBinary(dateTimeExtID, local.DateTime{})
+}
+
+// binaryMarshaler is implemented by pointers to local.Date and local.DateTime.
+type binaryMarshaler interface {
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+}
+
+// registerBinary registers the type of value as an extension type whose
+// payload is the binary encoding of the value.
+func registerBinary(extID int8, value interface{}) {
+	msgpack.RegisterExtEncoder(extID, value, func(e *msgpack.Encoder, v reflect.Value) ([]byte, error) {
+		return v.Interface().(interface{ MarshalBinary() ([]byte, error) }).MarshalBinary()
+	})
+	msgpack.RegisterExtDecoder(extID, value, func(d *msgpack.Decoder, v reflect.Value, extLen int) error {
+		return readBinary(d, v, extLen)
+	})
+
+	// The decoder installed by RegisterExtDecoder panics when it decodes
+	// nil into a value that is not a pointer, so replace it with one that
+	// sets the value to zero. Decoding into an interface value still uses
+	// the extension decoder registered above.
+	msgpack.Register(value, nil, func(d *msgpack.Decoder, v reflect.Value) error {
+		if !v.CanAddr() {
+			return fmt.Errorf("localmsgpack: cannot decode into nonaddressable %s", v.Type())
+		}
+		if c, err := d.PeekCode(); err == nil && c == msgpcode.Nil {
+			v.Set(reflect.Zero(v.Type()))
+			return d.DecodeNil()
+		}
+		id, extLen, err := d.DecodeExtHeader()
+		if err != nil {
+			return err
+		}
+		if id != extID {
+			return fmt.Errorf("localmsgpack: got extension type %d, want %d", id, extID)
+		}
+		return readBinary(d, v, extLen)
+	})
+}
+
+// readBinary reads an extension payload of extLen bytes and decodes it into v.
+func readBinary(d *msgpack.Decoder, v reflect.Value, extLen int) error {
+	data := make([]byte, extLen)
+	if err := d.ReadFull(data); err != nil {
+		return err
+	}
+	return v.Addr().Interface().(binaryMarshaler).UnmarshalBinary(data)
+}
@@ -0,0 +1,77 @@
+package localmsgpack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkg/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestRegister(t *testing.T) {
+	assert := assert.New(t)
+	Register(1, 2)
+	defer msgpack.UnregisterExt(1)
+	defer msgpack.UnregisterExt(2)
+
+	type testStruct struct {
+		Date     local.Date
+		DateTime local.DateTime
+		Ptr      *local.Date
+		Nil      *local.DateTime
+	}
+	d := local.DateFor(2025, time.September, 30)
+	v := testStruct{
+		Date:     d,
+		DateTime: local.DateTimeForNano(2025, time.September, 30, 15, 4, 5, 123456789),
+		Ptr:      &d,
+	}
+	data, err := msgpack.Marshal(v)
+	assert.NoError(err)
+
+	var v2 testStruct
+	assert.NoError(msgpack.Unmarshal(data, &v2))
+	assert.Equal(v, v2)
+
+	// fixext 4 is not used because the payload of a date is five bytes
+	data, err = msgpack.Marshal(d)
+	assert.NoError(err)
+	assert.Equal([]byte{0xc7, 0x05, 0x01, 0x10, 0x00, 0x00, 0x4f, 0x89}, data)
+
+	data, err = msgpack.Marshal(local.DateTimeFor(2025, time.September, 30, 15, 4, 5))
+	assert.NoError(err)
+	assert.Equal([]byte{0xc7, 0x09, 0x02, 0x10, 0, 0, 0, 0, 0x68, 0xdb, 0xf1, 0x65}, data)
+
+	// nil decodes as the zero value
+	v2.Date = d
+	assert.NoError(msgpack.Unmarshal([]byte{0x81, 0xa4, 'D', 'a', 't', 'e', 0xc0}, &v2))
+	assert.Equal(local.Date{}, v2.Date)
+
+	// nil decodes as a nil pointer, and as the zero value in a slice
+	v2.Ptr = &d
+	assert.NoError(msgpack.Unmarshal([]byte{0x81, 0xa3, 'P', 't', 'r', 0xc0}, &v2))
+	assert.Nil(v2.Ptr)
+	data, err = msgpack.Marshal(map[string]interface{}{"Ptr": d})
+	assert.NoError(err)
+	assert.NoError(msgpack.Unmarshal(data, &v2))
+	if assert.NotNil(v2.Ptr) {
+		assert.Equal(d, *v2.Ptr)
+	}
+	data, err = msgpack.Marshal([]interface{}{d, nil, d})
+	assert.NoError(err)
+	dates := []local.Date{d, d, d}
+	assert.NoError(msgpack.Unmarshal(data, &dates))
+	assert.Equal([]local.Date{d, {}, d}, dates)
+
+	// decoding into an interface value produces the registered type
+	var i interface{}
+	data, err = msgpack.Marshal(d)
+	assert.NoError(err)
+	assert.NoError(msgpack.Unmarshal(data, &i))
+	assert.Equal(d, i)
+	assert.Error(msgpack.Unmarshal([]byte{0xc7, 0x05, 0x02, 0x10, 0x00, 0x00, 0x4f, 0x89}, &v2.Date))
+
+	var dt local.DateTime
+	assert.Error(msgpack.Unmarshal([]byte{0xc7, 0x02, 0x02, 0x10, 0x00}, &dt))
+}
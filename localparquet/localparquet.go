@@ -0,0 +1,165 @@
+// Package localparquet maps the local package types to Apache Parquet
+// logical types.
+//
+// A local.Date is represented by the DATE logical type, which annotates an
+// INT32 containing the number of days since January 1, 1970. A local.DateTime
+// is represented by the TIMESTAMP logical type with isAdjustedToUTC=false,
+// which annotates an INT64 containing the number of milliseconds, microseconds
+// or nanoseconds since 1970-01-01T00:00:00, without reference to a timezone.
+//
+// The To and From functions convert between the local types and the values
+// of the physical types, for use with Parquet libraries that do not know about
+// the local package. The Append and Read functions implement the PLAIN encoding,
+// in which INT32 and INT64 values are stored in little-endian order.
+package localparquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/spkg/local"
+)
+
+var (
+	errDateOutOfRange     = errors.New("localparquet: date out of range for INT32")
+	errDateTimeOutOfRange = errors.New("localparquet: date-time out of range for INT64")
+	errInvalidUnit        = errors.New("localparquet: invalid time unit")
+	errShortBuffer        = errors.New("localparquet: buffer too short")
+)
+
+// TimeUnit is the unit of a TIMESTAMP logical type.
+type TimeUnit int
+
+// Time units of the TIMESTAMP logical type.
+const (
+	Millis TimeUnit = iota
+	Micros
+	Nanos
+)
+
+// String returns the name of the unit used in Parquet schemas, for example "MILLIS".
+func (u TimeUnit) String() string {
+	switch u {
+	case Millis:
+		return "MILLIS"
+	case Micros:
+		return "MICROS"
+	case Nanos:
+		return "NANOS"
+	}
+	return "TimeUnit(" + strconv.Itoa(int(u)) + ")"
+}
+
+// LogicalType returns the TIMESTAMP logical type for a local date-time in
+// the unit, in the notation used by Parquet schemas, for example
+// "TIMESTAMP(isAdjustedToUTC=false, unit=MILLIS)".
+func (u TimeUnit) LogicalType() string {
+	return "TIMESTAMP(isAdjustedToUTC=false, unit=" + u.String() + ")"
+}
+
+// perSecond returns the number of units in a second.
+func (u TimeUnit) perSecond() (int64, bool) {
+	switch u {
+	case Millis:
+		return 1e3, true
+	case Micros:
+		return 1e6, true
+	case Nanos:
+		return 1e9, true
+	}
+	return 0, false
+}
+
+// ToDate returns the value of the DATE logical type for d,
+// the number of days since January 1, 1970.
+func ToDate(d local.Date) (int32, error) {
+	days, ok := d.UnixDays()
+	if !ok {
+		return 0, errDateOutOfRange
+	}
+	return days, nil
+}
+
+// FromDate returns the local date for a value of the DATE logical type.
+func FromDate(days int32) local.Date {
+	return local.DateFromUnixDays(days)
+}
+
+// ToTimestamp returns the value of the TIMESTAMP logical type with
+// isAdjustedToUTC=false for dt in the unit. Any part of a unit is truncated.
+// With the Nanos unit, only date-times between the years 1677 and 2262 can
+// be represented.
+func ToTimestamp(dt local.DateTime, unit TimeUnit) (int64, error) {
+	scale, ok := unit.perSecond()
+	if !ok {
+		return 0, errInvalidUnit
+	}
+	sec := dt.Unix()
+	if sec < math.MinInt64/scale || sec >= math.MaxInt64/scale {
+		return 0, errDateTimeOutOfRange
+	}
+	return sec*scale + int64(dt.Nanosecond())/(1e9/scale), nil
+}
+
+// FromTimestamp returns the local date-time for a value of the TIMESTAMP
+// logical type with isAdjustedToUTC=false in the unit.
+func FromTimestamp(v int64, unit TimeUnit) (local.DateTime, error) {
+	scale, ok := unit.perSecond()
+	if !ok {
+		return local.DateTime{}, errInvalidUnit
+	}
+	sec := v / scale
+	frac := v % scale
+	if frac < 0 {
+		sec--
+		frac += scale
+	}
+	return local.DateTimeFromUnix(sec, frac*(1e9/scale)), nil
+}
+
+// AppendDate appends the PLAIN encoding of d as a DATE to b.
+func AppendDate(b []byte, d local.Date) ([]byte, error) {
+	days, err := ToDate(d)
+	if err != nil {
+		return b, err
+	}
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], uint32(days))
+	return append(b, buf[:]...), nil
+}
+
+// AppendTimestamp appends the PLAIN encoding of dt as a TIMESTAMP
+// in the unit to b.
+func AppendTimestamp(b []byte, dt local.DateTime, unit TimeUnit) ([]byte, error) {
+	v, err := ToTimestamp(dt, unit)
+	if err != nil {
+		return b, err
+	}
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(v))
+	return append(b, buf[:]...), nil
+}
+
+// ReadDate reads the PLAIN encoding of a DATE from the start of b.
+// It returns the date and the number of bytes read.
+func ReadDate(b []byte) (local.Date, int, error) {
+	if len(b) < 4 {
+		return local.Date{}, 0, errShortBuffer
+	}
+	return FromDate(int32(binary.LittleEndian.Uint32(b))), 4, nil
+}
+
+// ReadTimestamp reads the PLAIN encoding of a TIMESTAMP in the unit
+// from the start of b. It returns the date-time and the number of bytes read.
+func ReadTimestamp(b []byte, unit TimeUnit) (local.DateTime, int, error) {
+	if len(b) < 8 {
+		return local.DateTime{}, 0, errShortBuffer
+	}
+	dt, err := FromTimestamp(int64(binary.LittleEndian.Uint64(b)), unit)
+	if err != nil {
+		return local.DateTime{}, 0, err
+	}
+	return dt, 8, nil
+}
@@ -0,0 +1,93 @@
+package localparquet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkg/local"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		date  local.Date
+		days  int32
+		plain []byte
+	}{
+		{local.DateFor(1970, time.January, 1), 0, []byte{0, 0, 0, 0}},
+		{local.DateFor(2025, time.September, 30), 20361, []byte{0x89, 0x4f, 0, 0}},
+		{local.DateFor(1969, time.December, 31), -1, []byte{0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, tc := range testCases {
+		days, err := ToDate(tc.date)
+		assert.NoError(err)
+		assert.Equal(tc.days, days)
+		assert.Equal(tc.date, FromDate(days))
+
+		b, err := AppendDate([]byte{1}, tc.date)
+		assert.NoError(err)
+		assert.Equal(append([]byte{1}, tc.plain...), b)
+		d, n, err := ReadDate(b[1:])
+		assert.NoError(err)
+		assert.Equal(4, n)
+		assert.Equal(tc.date, d)
+	}
+
+	_, err := ToDate(local.DateFromDays(1 << 31))
+	assert.Error(err)
+	_, _, err = ReadDate([]byte{1, 2, 3})
+	assert.Error(err)
+}
+
+func TestTimestamp(t *testing.T) {
+	assert := assert.New(t)
+	dt := local.DateTimeForNano(2025, time.September, 30, 15, 4, 5, 123456789)
+	before := local.DateTimeForNano(1969, time.December, 31, 23, 59, 59, 999999999)
+	testCases := []struct {
+		dt    local.DateTime
+		unit  TimeUnit
+		value int64
+	}{
+		{dt, Millis, 1759244645123},
+		{dt, Micros, 1759244645123456},
+		{dt, Nanos, 1759244645123456789},
+		{before, Millis, -1},
+		{before, Micros, -1},
+		{before, Nanos, -1},
+	}
+	for _, tc := range testCases {
+		v, err := ToTimestamp(tc.dt, tc.unit)
+		assert.NoError(err)
+		assert.Equal(tc.value, v, tc.unit.String())
+
+		scale := map[TimeUnit]time.Duration{Millis: time.Millisecond, Micros: time.Microsecond, Nanos: time.Nanosecond}[tc.unit]
+		dt2, err := FromTimestamp(v, tc.unit)
+		assert.NoError(err)
+		assert.Equal(tc.dt.Truncate(scale), dt2)
+
+		b, err := AppendTimestamp(nil, tc.dt, tc.unit)
+		assert.NoError(err)
+		assert.Len(b, 8)
+		dt2, n, err := ReadTimestamp(b, tc.unit)
+		assert.NoError(err)
+		assert.Equal(8, n)
+		assert.Equal(tc.dt.Truncate(scale), dt2)
+	}
+
+	_, err := ToTimestamp(local.DateTimeFor(2263, time.January, 1, 0, 0, 0), Nanos)
+	assert.Error(err)
+	_, err = ToTimestamp(local.DateTimeFor(1677, time.January, 1, 0, 0, 0), Nanos)
+	assert.Error(err)
+	_, err = ToTimestamp(local.DateTimeFor(1678, time.January, 1, 0, 0, 0), Nanos)
+	assert.NoError(err)
+	_, err = ToTimestamp(dt, TimeUnit(3))
+	assert.Error(err)
+	_, err = FromTimestamp(0, TimeUnit(-1))
+	assert.Error(err)
+	_, _, err = ReadTimestamp(make([]byte, 7), Millis)
+	assert.Error(err)
+
+	assert.Equal("TIMESTAMP(isAdjustedToUTC=false, unit=MICROS)", Micros.LogicalType())
+	assert.Equal("TimeUnit(7)", TimeUnit(7).String())
+}
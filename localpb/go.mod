@@ -0,0 +1,17 @@
+module github.com/spkg/local/localpb
+
+go 1.23.0
+
+require (
+	github.com/spkg/local v1.0.0
+	github.com/stretchr/testify v1.9.0
+	google.golang.org/genproto v0.0.0-20250603155806-513f23925822
+	google.golang.org/protobuf v1.36.6
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/go-cmp v0.7.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
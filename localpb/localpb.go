@@ -0,0 +1,203 @@
+// Package localpb converts between the local package types and the
+// google.type.Date, google.type.TimeOfDay and google.type.DateTime
+// Protocol Buffers messages.
+//
+// The messages are the pre-generated types from google.golang.org/genproto,
+// so no protoc toolchain is required. The local package itself does not
+// depend on Protocol Buffers.
+//
+// A google.type.Date can represent a partial date, with zero for the fields
+// that are not specified. A full date converts to a local.Date, a year and month
+// with a zero day converts to a local.YearMonth, a month and day with a zero year
+// converts to a local.MonthDay, and a year with a zero month and day converts
+// to a local.Year.
+package localpb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spkg/local"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+)
+
+var (
+	errInvalidDate      = errors.New("localpb: invalid google.type.Date")
+	errInvalidTimeOfDay = errors.New("localpb: invalid google.type.TimeOfDay")
+	errInvalidDateTime  = errors.New("localpb: invalid google.type.DateTime")
+	errTimeOffset       = errors.New("localpb: google.type.DateTime has a time offset")
+	errYearOutOfRange   = errors.New("localpb: year out of range for google.type.Date")
+)
+
+// The range of years that can be represented by a google.type.Date.
+const (
+	minYear = 1
+	maxYear = 9999
+)
+
+// FromDate returns the local date for a full google.type.Date.
+// An error is returned if any of the year, month or day are zero.
+func FromDate(pb *date.Date) (local.Date, error) {
+	year, month, day := int(pb.GetYear()), int(pb.GetMonth()), int(pb.GetDay())
+	if year < minYear || year > maxYear || !isValidDate(year, month, day) {
+		return local.Date{}, errInvalidDate
+	}
+	return local.DateFor(year, time.Month(month), day), nil
+}
+
+// ToDate returns a full google.type.Date for the local date. An error
+// is returned if the year is outside the range [1, 9999].
+func ToDate(d local.Date) (*date.Date, error) {
+	year, month, day := d.Date()
+	if year < minYear || year > maxYear {
+		return nil, errYearOutOfRange
+	}
+	return &date.Date{Year: int32(year), Month: int32(month), Day: int32(day)}, nil
+}
+
+// FromYearMonth returns the local year-month for a google.type.Date
+// with a year and month, and a zero day.
+func FromYearMonth(pb *date.Date) (local.YearMonth, error) {
+	year, month := int(pb.GetYear()), int(pb.GetMonth())
+	if year < minYear || year > maxYear || month < 1 || month > 12 || pb.GetDay() != 0 {
+		return local.YearMonth{}, errInvalidDate
+	}
+	return local.YearMonthFor(year, time.Month(month)), nil
+}
+
+// ToYearMonth returns a google.type.Date with a zero day for the local
+// year-month. An error is returned if the year is outside the range [1, 9999].
+func ToYearMonth(ym local.YearMonth) (*date.Date, error) {
+	year := ym.Year()
+	if year < minYear || year > maxYear {
+		return nil, errYearOutOfRange
+	}
+	return &date.Date{Year: int32(year), Month: int32(ym.Month())}, nil
+}
+
+// FromMonthDay returns the local month-day for a google.type.Date
+// with a month and day, and a zero year. February 29 is permitted.
+func FromMonthDay(pb *date.Date) (local.MonthDay, error) {
+	month, day := int(pb.GetMonth()), int(pb.GetDay())
+	if pb.GetYear() != 0 || !isValidDate(2000, month, day) {
+		return local.MonthDay{}, errInvalidDate
+	}
+	return local.MonthDayFor(time.Month(month), day), nil
+}
+
+// ToMonthDay returns a google.type.Date with a zero year for the local month-day.
+func ToMonthDay(md local.MonthDay) *date.Date {
+	return &date.Date{Month: int32(md.Month()), Day: int32(md.Day())}
+}
+
+// FromYear returns the local year for a google.type.Date with a year,
+// and a zero month and day.
+func FromYear(pb *date.Date) (local.Year, error) {
+	year := int(pb.GetYear())
+	if year < minYear || year > maxYear || pb.GetMonth() != 0 || pb.GetDay() != 0 {
+		return 0, errInvalidDate
+	}
+	return local.Year(year), nil
+}
+
+// ToYear returns a google.type.Date with a zero month and day for the local
+// year. An error is returned if the year is outside the range [1, 9999].
+func ToYear(y local.Year) (*date.Date, error) {
+	if y < minYear || y > maxYear {
+		return nil, errYearOutOfRange
+	}
+	return &date.Date{Year: int32(y)}, nil
+}
+
+// FromDateTime returns the local date-time for a google.type.DateTime.
+// The date-time must have a year, and must not have a UTC offset or a time
+// zone, because a local date-time does not refer to a time zone. A time of
+// 24:00:00 is the first instant of the following day. Nanoseconds are kept
+// regardless of local.DateTimePrecision.
+func FromDateTime(pb *datetime.DateTime) (local.DateTime, error) {
+	if pb.GetUtcOffset() != nil || pb.GetTimeZone() != nil {
+		return local.DateTime{}, errTimeOffset
+	}
+	year, month, day := int(pb.GetYear()), int(pb.GetMonth()), int(pb.GetDay())
+	if year < minYear || year > maxYear || !isValidDate(year, month, day) {
+		return local.DateTime{}, errInvalidDateTime
+	}
+	hour, minute, second, nanos := int(pb.GetHours()), int(pb.GetMinutes()), int(pb.GetSeconds()), int(pb.GetNanos())
+	if !isValidTimeOfDay(hour, minute, second, nanos) {
+		return local.DateTime{}, errInvalidDateTime
+	}
+	return local.DateTimeForNano(year, time.Month(month), day, hour, minute, second, nanos), nil
+}
+
+// ToDateTime returns a google.type.DateTime without a time offset for the
+// local date-time. An error is returned if the year is outside the range [1, 9999].
+func ToDateTime(dt local.DateTime) (*datetime.DateTime, error) {
+	year, month, day, hour, minute, second := dt.DateTime()
+	if year < minYear || year > maxYear {
+		return nil, errYearOutOfRange
+	}
+	return &datetime.DateTime{
+		Year:    int32(year),
+		Month:   int32(month),
+		Day:     int32(day),
+		Hours:   int32(hour),
+		Minutes: int32(minute),
+		Seconds: int32(second),
+		Nanos:   int32(dt.Nanosecond()),
+	}, nil
+}
+
+// FromDateAndTimeOfDay returns the local date-time for a full google.type.Date
+// and a google.type.TimeOfDay. A time of 24:00:00 is the first instant of the
+// following day. Nanoseconds are kept regardless of local.DateTimePrecision.
+func FromDateAndTimeOfDay(d *date.Date, t *timeofday.TimeOfDay) (local.DateTime, error) {
+	ld, err := FromDate(d)
+	if err != nil {
+		return local.DateTime{}, err
+	}
+	if t == nil {
+		return local.DateTime{}, errInvalidTimeOfDay
+	}
+	hour, minute, second, nanos := int(t.Hours), int(t.Minutes), int(t.Seconds), int(t.Nanos)
+	if !isValidTimeOfDay(hour, minute, second, nanos) {
+		return local.DateTime{}, errInvalidTimeOfDay
+	}
+	year, month, day := ld.Date()
+	return local.DateTimeForNano(year, month, day, hour, minute, second, nanos), nil
+}
+
+// ToTimeOfDay returns a google.type.TimeOfDay for the time of day of the
+// local date-time.
+func ToTimeOfDay(dt local.DateTime) *timeofday.TimeOfDay {
+	hour, minute, second := dt.Clock()
+	return &timeofday.TimeOfDay{
+		Hours:   int32(hour),
+		Minutes: int32(minute),
+		Seconds: int32(second),
+		Nanos:   int32(dt.Nanosecond()),
+	}
+}
+
+// isValidDate reports whether year, month and day specify a date
+// without requiring normalization.
+func isValidDate(year, month, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	return day <= local.YearMonthFor(year, time.Month(month)).Days()
+}
+
+// isValidTimeOfDay reports whether the fields specify a time of day.
+// Leap seconds are not supported, but 24:00:00 is permitted for the
+// end of the day.
+func isValidTimeOfDay(hour, minute, second, nanos int) bool {
+	if hour == 24 {
+		return minute == 0 && second == 0 && nanos == 0
+	}
+	return hour >= 0 && hour < 24 &&
+		minute >= 0 && minute < 60 &&
+		second >= 0 && second < 60 &&
+		nanos >= 0 && nanos < 1e9
+}
@@ -0,0 +1,135 @@
+package localpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkg/local"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDate(t *testing.T) {
+	assert := assert.New(t)
+	d := local.DateFor(2025, time.September, 30)
+	pb, err := ToDate(d)
+	assert.NoError(err)
+	assert.Equal(int32(2025), pb.Year)
+	assert.Equal(int32(9), pb.Month)
+	assert.Equal(int32(30), pb.Day)
+	d2, err := FromDate(pb)
+	assert.NoError(err)
+	assert.Equal(d, d2)
+
+	_, err = ToDate(local.DateFor(10000, time.January, 1))
+	assert.Error(err)
+	_, err = ToDate(local.DateFor(0, time.December, 31))
+	assert.Error(err)
+
+	for _, pb := range []*date.Date{
+		nil,
+		{Year: 2025, Month: 9},
+		{Month: 9, Day: 30},
+		{Year: 2025},
+		{Year: 2025, Month: 2, Day: 29},
+		{Year: 2025, Month: 13, Day: 1},
+		{Year: 10000, Month: 1, Day: 1},
+		{Year: -1, Month: 1, Day: 1},
+	} {
+		_, err := FromDate(pb)
+		assert.Error(err, "%v", pb)
+	}
+}
+
+func TestPartialDates(t *testing.T) {
+	assert := assert.New(t)
+
+	ym := local.YearMonthFor(2025, time.September)
+	pb, err := ToYearMonth(ym)
+	assert.NoError(err)
+	assert.Equal(&date.Date{Year: 2025, Month: 9}, pb)
+	ym2, err := FromYearMonth(pb)
+	assert.NoError(err)
+	assert.Equal(ym, ym2)
+	_, err = FromYearMonth(&date.Date{Year: 2025, Month: 9, Day: 1})
+	assert.Error(err)
+	_, err = ToYearMonth(local.YearMonthFor(0, time.January))
+	assert.Error(err)
+
+	md := local.MonthDayFor(time.February, 29)
+	pb = ToMonthDay(md)
+	assert.Equal(&date.Date{Month: 2, Day: 29}, pb)
+	md2, err := FromMonthDay(pb)
+	assert.NoError(err)
+	assert.Equal(md, md2)
+	_, err = FromMonthDay(&date.Date{Year: 2024, Month: 2, Day: 29})
+	assert.Error(err)
+	_, err = FromMonthDay(&date.Date{Month: 2, Day: 30})
+	assert.Error(err)
+
+	pb, err = ToYear(local.Year(2025))
+	assert.NoError(err)
+	assert.Equal(&date.Date{Year: 2025}, pb)
+	y, err := FromYear(pb)
+	assert.NoError(err)
+	assert.Equal(local.Year(2025), y)
+	_, err = FromYear(&date.Date{Year: 2025, Day: 1})
+	assert.Error(err)
+	_, err = ToYear(local.Year(0))
+	assert.Error(err)
+}
+
+func TestDateTime(t *testing.T) {
+	assert := assert.New(t)
+	dt := local.DateTimeForNano(2025, time.September, 30, 15, 4, 5, 123456789)
+	pb, err := ToDateTime(dt)
+	assert.NoError(err)
+	assert.Equal(int32(2025), pb.Year)
+	assert.Equal(int32(15), pb.Hours)
+	assert.Equal(int32(123456789), pb.Nanos)
+	assert.Nil(pb.TimeOffset)
+	dt2, err := FromDateTime(pb)
+	assert.NoError(err)
+	assert.Equal(dt, dt2)
+
+	dt2, err = FromDateTime(&datetime.DateTime{Year: 2025, Month: 12, Day: 31, Hours: 24})
+	assert.NoError(err)
+	assert.Equal(local.DateTimeFor(2026, time.January, 1, 0, 0, 0), dt2)
+
+	for _, pb := range []*datetime.DateTime{
+		nil,
+		{Month: 9, Day: 30, Hours: 12},
+		{Year: 2025, Month: 9, Day: 31},
+		{Year: 2025, Month: 9, Day: 30, Hours: 24, Minutes: 1},
+		{Year: 2025, Month: 9, Day: 30, Seconds: 60},
+		{Year: 2025, Month: 9, Day: 30, Nanos: 1e9},
+		{Year: 2025, Month: 9, Day: 30, TimeOffset: &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(time.Hour)}},
+		{Year: 2025, Month: 9, Day: 30, TimeOffset: &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "Australia/Sydney"}}},
+	} {
+		_, err := FromDateTime(pb)
+		assert.Error(err, "%v", pb)
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	assert := assert.New(t)
+	dt := local.DateTimeForNano(2025, time.September, 30, 15, 4, 5, 500)
+	tod := ToTimeOfDay(dt)
+	assert.Equal(&timeofday.TimeOfDay{Hours: 15, Minutes: 4, Seconds: 5, Nanos: 500}, tod)
+
+	d, err := ToDate(local.DateFor(dt.Date()))
+	assert.NoError(err)
+	dt2, err := FromDateAndTimeOfDay(d, tod)
+	assert.NoError(err)
+	assert.Equal(dt, dt2)
+
+	_, err = FromDateAndTimeOfDay(d, nil)
+	assert.Error(err)
+	_, err = FromDateAndTimeOfDay(d, &timeofday.TimeOfDay{Hours: 25})
+	assert.Error(err)
+	_, err = FromDateAndTimeOfDay(&date.Date{Year: 2025, Month: 9}, tod)
+	assert.Error(err)
+}
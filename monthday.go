@@ -0,0 +1,241 @@
+package local
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidMonthDayFormat = errors.New("invalid month-day format")
+
+// MonthDay represents a day of a month that recurs every year, without
+// a year, a time or a timezone. Useful for representing birthdays and
+// anniversaries, for example. February 29 is a valid MonthDay.
+//
+// The zero value of MonthDay is January 1.
+type MonthDay struct {
+	md int // (month-1)*31 + day-1, so that month-days sort in order
+}
+
+// LeapDayPolicy specifies the date used for February 29 in years
+// that are not leap years.
+type LeapDayPolicy int
+
+// Leap day policies.
+const (
+	LeapDayToFeb28 LeapDayPolicy = iota // February 29 occurs on February 28
+	LeapDayToMar1                       // February 29 occurs on March 1
+)
+
+// MonthDayFor returns the month-day for the given month and day.
+// The month and day may be outside their usual ranges and will be
+// normalized as they would be in a leap year, so that February 30
+// is March 1.
+func MonthDayFor(month time.Month, day int) MonthDay {
+	return MonthDayFromDate(DateFor(2000, month, day))
+}
+
+// MonthDayFromDate returns the month and day of d.
+func MonthDayFromDate(d Date) MonthDay {
+	return monthDayFor(d.Month(), d.Day())
+}
+
+// monthDayFor returns the month-day for a valid month and day.
+func monthDayFor(month time.Month, day int) MonthDay {
+	return MonthDay{md: (int(month)-1)*31 + day - 1}
+}
+
+// Month returns the month of md.
+func (md MonthDay) Month() time.Month {
+	return time.Month(md.md/31 + 1)
+}
+
+// Day returns the day of the month of md.
+func (md MonthDay) Day() int {
+	return md.md%31 + 1
+}
+
+// After reports whether md is later in the year than e.
+func (md MonthDay) After(e MonthDay) bool {
+	return md.md > e.md
+}
+
+// Before reports whether md is earlier in the year than e.
+func (md MonthDay) Before(e MonthDay) bool {
+	return md.md < e.md
+}
+
+// Equal reports whether md and e represent the same month-day.
+func (md MonthDay) Equal(e MonthDay) bool {
+	return md.md == e.md
+}
+
+// IsZero reports whether md represents the zero month-day, January 1.
+func (md MonthDay) IsZero() bool {
+	return md.md == 0
+}
+
+// IsLeapDay reports whether md is February 29.
+func (md MonthDay) IsLeapDay() bool {
+	return md.Month() == time.February && md.Day() == 29
+}
+
+// AtYear returns the date on which md occurs in year. If md is February 29
+// and year is not a leap year, the date is chosen according to policy.
+func (md MonthDay) AtYear(year int, policy LeapDayPolicy) Date {
+	if md.IsLeapDay() && daysIn(time.February, year) != 29 {
+		if policy == LeapDayToMar1 {
+			return DateFor(year, time.March, 1)
+		}
+		return DateFor(year, time.February, 28)
+	}
+	return DateFor(year, md.Month(), md.Day())
+}
+
+// Next returns the first date on or after d on which md occurs. If md is
+// February 29, the date in years that are not leap years is chosen
+// according to policy.
+func (md MonthDay) Next(d Date, policy LeapDayPolicy) Date {
+	next := md.AtYear(d.Year(), policy)
+	if next.Before(d) {
+		next = md.AtYear(d.Year()+1, policy)
+	}
+	return next
+}
+
+// Format returns a textual representation of the month-day formatted
+// according to layout, which takes the same form as the standard library
+// time package. Year fields in the layout are formatted as the year 2000.
+func (md MonthDay) Format(layout string) string {
+	return md.AtYear(2000, LeapDayToFeb28).Format(layout)
+}
+
+// String returns a string representation of md. The format
+// returned is compatible with ISO 8601: --mm-dd.
+func (md MonthDay) String() string {
+	return fmt.Sprintf("--%02d-%02d", int(md.Month()), md.Day())
+}
+
+var monthDayRegexp = struct {
+	lenient *regexp.Regexp
+	strict  *regexp.Regexp
+}{
+	lenient: regexp.MustCompile(`^(?:--)?(\d{1,2})-(\d{1,2})$|^--(\d{2})(\d{2})$`),
+	strict:  regexp.MustCompile(`^--(\d{2})-(\d{2})$`),
+}
+
+// MonthDayParse parses a string and returns the month-day it represents.
+// Leading and trailing space and quotation marks are ignored. The following
+// formats are recognized: --mm-dd, --mmdd and mm-dd. The day must be valid
+// for the month in a leap year.
+func MonthDayParse(s string) (MonthDay, error) {
+	return parseMonthDay(strings.Trim(s, " \t\"'"), monthDayRegexp.lenient)
+}
+
+func parseMonthDay(s string, re *regexp.Regexp) (MonthDay, error) {
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return MonthDay{}, errInvalidMonthDayFormat
+	}
+	if match[1] == "" {
+		match = match[2:]
+	}
+
+	// no error checking here because matching the regexp
+	// guarantees that parsing the strings will succeed.
+	month, _ := strconv.Atoi(match[1])
+	day, _ := strconv.Atoi(match[2])
+	if !isValidDate(2000, month, day) {
+		return MonthDay{}, errInvalidMonthDayFormat
+	}
+	return monthDayFor(time.Month(month), day), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is two bytes: the month and the day.
+func (md MonthDay) MarshalBinary() ([]byte, error) {
+	return []byte{byte(md.Month()), byte(md.Day())}, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (md *MonthDay) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 || !isValidDate(2000, int(data[0]), int(data[1])) {
+		return errors.New("local.MonthDay.UnmarshalBinary: invalid data")
+	}
+	*md = monthDayFor(time.Month(data[0]), int(data[1]))
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The month-day is a quoted string in an ISO 8601 format (--mm-dd).
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + md.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The month-day is expected to be a quoted string in one of the formats
+// recognized by MonthDayParse. If StrictJSON is set, the month-day
+// must be a quoted string in the format --mm-dd.
+func (md *MonthDay) UnmarshalJSON(data []byte) (err error) {
+	if StrictJSON {
+		s, ok := unquoteJSON(data)
+		if !ok {
+			return errInvalidMonthDayFormat
+		}
+		*md, err = parseMonthDay(s, monthDayRegexp.strict)
+		return
+	}
+	*md, err = MonthDayParse(string(data))
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The format is --mm-dd.
+func (md MonthDay) MarshalText() ([]byte, error) {
+	return []byte(md.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The month-day is expected to be in one of the formats recognized
+// by MonthDayParse.
+func (md *MonthDay) UnmarshalText(data []byte) (err error) {
+	*md, err = MonthDayParse(string(data))
+	return
+}
+
+// Scan implements the sql.Scanner interface. A string value is expected
+// to be in one of the formats recognized by MonthDayParse. A time.Time value
+// is converted to the month and day of its date.
+func (md *MonthDay) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		md1, err := MonthDayParse(v)
+		if err != nil {
+			return err
+		}
+		*md = md1
+	case []byte:
+		md1, err := MonthDayParse(string(v))
+		if err != nil {
+			return err
+		}
+		*md = md1
+	case time.Time:
+		*md = MonthDayFromDate(DateFromTime(v))
+	case nil:
+		*md = MonthDay{}
+	default:
+		return errors.New("cannot convert to local.MonthDay")
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface. SQL has no month-day
+// type, so the value is a string in the format --mm-dd.
+func (md MonthDay) Value() (driver.Value, error) {
+	return md.String(), nil
+}
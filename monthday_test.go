@@ -0,0 +1,177 @@
+package local
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthDayFor(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Month  time.Month
+		Day    int
+		String string
+	}{
+		{time.September, 30, "--09-30"},
+		{time.February, 29, "--02-29"},
+		{time.February, 30, "--03-01"},
+		{time.January, 0, "--12-31"},
+		{time.January, 1, "--01-01"},
+	}
+
+	for _, tc := range testCases {
+		md := MonthDayFor(tc.Month, tc.Day)
+		assert.Equal(tc.String, md.String())
+	}
+	assert.True(MonthDay{}.Equal(MonthDayFor(time.January, 1)))
+	assert.True(MonthDay{}.IsZero())
+	md := MonthDayFromDate(DateFor(2024, 2, 29))
+	assert.Equal(time.February, md.Month())
+	assert.Equal(29, md.Day())
+	assert.True(md.IsLeapDay())
+	assert.True(md.Before(MonthDayFor(time.March, 1)))
+	assert.True(md.After(MonthDayFor(time.February, 28)))
+	assert.Equal("Feb 29", md.Format("Jan _2"))
+}
+
+func TestMonthDayAtYear(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		MonthDay MonthDay
+		Year     int
+		Policy   LeapDayPolicy
+		Expected Date
+	}{
+		{MonthDayFor(time.September, 30), 2025, LeapDayToFeb28, DateFor(2025, 9, 30)},
+		{MonthDayFor(time.February, 29), 2024, LeapDayToFeb28, DateFor(2024, 2, 29)},
+		{MonthDayFor(time.February, 29), 2024, LeapDayToMar1, DateFor(2024, 2, 29)},
+		{MonthDayFor(time.February, 29), 2025, LeapDayToFeb28, DateFor(2025, 2, 28)},
+		{MonthDayFor(time.February, 29), 2025, LeapDayToMar1, DateFor(2025, 3, 1)},
+		{MonthDayFor(time.February, 29), 1900, LeapDayToMar1, DateFor(1900, 3, 1)},
+	}
+
+	for _, tc := range testCases {
+		actual := tc.MonthDay.AtYear(tc.Year, tc.Policy)
+		assert.True(tc.Expected.Equal(actual), "%v %d: expected %v, actual %v", tc.MonthDay, tc.Year, tc.Expected, actual)
+	}
+}
+
+func TestMonthDayNext(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		MonthDay MonthDay
+		Date     Date
+		Policy   LeapDayPolicy
+		Expected Date
+	}{
+		{MonthDayFor(time.October, 18), DateFor(2025, 9, 30), LeapDayToFeb28, DateFor(2025, 10, 18)},
+		{MonthDayFor(time.October, 18), DateFor(2025, 10, 18), LeapDayToFeb28, DateFor(2025, 10, 18)},
+		{MonthDayFor(time.October, 18), DateFor(2025, 10, 19), LeapDayToFeb28, DateFor(2026, 10, 18)},
+		{MonthDayFor(time.February, 29), DateFor(2025, 3, 1), LeapDayToMar1, DateFor(2025, 3, 1)},
+		{MonthDayFor(time.February, 29), DateFor(2025, 3, 2), LeapDayToMar1, DateFor(2026, 3, 1)},
+		{MonthDayFor(time.February, 29), DateFor(2025, 3, 1), LeapDayToFeb28, DateFor(2026, 2, 28)},
+		{MonthDayFor(time.February, 29), DateFor(2027, 3, 2), LeapDayToFeb28, DateFor(2028, 2, 29)},
+	}
+
+	for _, tc := range testCases {
+		actual := tc.MonthDay.Next(tc.Date, tc.Policy)
+		assert.True(tc.Expected.Equal(actual), "%v %v: expected %v, actual %v", tc.MonthDay, tc.Date, tc.Expected, actual)
+	}
+}
+
+func TestMonthDayParse(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Error    bool
+		Expected MonthDay
+	}{
+		{Text: "--09-30", Expected: MonthDayFor(9, 30)},
+		{Text: "--0930", Expected: MonthDayFor(9, 30)},
+		{Text: " \"9-3\" ", Expected: MonthDayFor(9, 3)},
+		{Text: "--02-29", Expected: MonthDayFor(2, 29)},
+		{Text: "--02-30", Error: true},
+		{Text: "--13-01", Error: true},
+		{Text: "--00-01", Error: true},
+		{Text: "2025-09-30", Error: true},
+		{Text: "--093", Error: true},
+	}
+
+	for _, tc := range testCases {
+		md, err := MonthDayParse(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+		} else if assert.NoError(err, tc.Text) {
+			assert.True(tc.Expected.Equal(md), tc.Text)
+		}
+	}
+}
+
+func TestMonthDayMarshal(t *testing.T) {
+	assert := assert.New(t)
+	var v struct {
+		Birthday MonthDay `json:"birthday"`
+	}
+	v.Birthday = MonthDayFor(time.February, 29)
+	data, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"birthday":"--02-29"}`, string(data))
+
+	assert.NoError(json.Unmarshal([]byte(`{"birthday":"3-7"}`), &v))
+	assert.Equal("--03-07", v.Birthday.String())
+
+	StrictJSON = true
+	defer func() { StrictJSON = false }()
+	assert.Error(json.Unmarshal([]byte(`{"birthday":"3-7"}`), &v))
+	assert.NoError(json.Unmarshal([]byte(`{"birthday":"--12-25"}`), &v))
+	assert.Equal("--12-25", v.Birthday.String())
+
+	data, err = v.Birthday.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{12, 25}, data)
+	var md MonthDay
+	assert.NoError(md.UnmarshalBinary(data))
+	assert.True(md.Equal(v.Birthday))
+	assert.Error(md.UnmarshalBinary([]byte{2, 30}))
+	assert.Error(md.UnmarshalBinary([]byte{1}))
+
+	text, err := md.MarshalText()
+	assert.NoError(err)
+	assert.Equal("--12-25", string(text))
+	assert.NoError(md.UnmarshalText([]byte("--01-02")))
+	assert.Equal("--01-02", md.String())
+}
+
+func TestMonthDayScanValue(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Value    interface{}
+		Error    bool
+		Expected MonthDay
+	}{
+		{Value: "--11-13", Expected: MonthDayFor(11, 13)},
+		{Value: []byte("--12-31"), Expected: MonthDayFor(12, 31)},
+		{Value: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), Expected: MonthDayFor(2, 29)},
+		{Value: nil, Expected: MonthDay{}},
+		{Value: []byte("xxx"), Error: true},
+		{Value: int64(11), Error: true},
+	}
+
+	for _, tc := range testCases {
+		var md MonthDay
+		err := md.Scan(tc.Value)
+		if tc.Error {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+			assert.True(md.Equal(tc.Expected))
+		}
+	}
+
+	v, err := MonthDayFor(2, 29).Value()
+	assert.NoError(err)
+	assert.Equal("--02-29", v)
+}
@@ -3,67 +3,108 @@ package local
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"strings"
 )
 
 var errNilPtr = errors.New("destination pointer is nil")
 
-// NullDate represents a Date that may be null.
-// NullDate implements the sql Scanner interface so
-// it can be used as a scan destination, similar to
-// sql.NullString.
-type NullDate struct {
-	Date  Date
-	Valid bool // Valid is true if Date is not NULL
+// Nullable is the constraint satisfied by the local value types
+// that can be wrapped by Null.
+type Nullable interface {
+	Date | DateTime | YearMonth | MonthDay | ISOWeek
+	IsZero() bool
+	MarshalBinary() ([]byte, error)
+	MarshalJSON() ([]byte, error)
+	MarshalText() ([]byte, error)
+	Value() (driver.Value, error)
 }
 
-// NullDateFrom returns a NullDate whose value is
-// obtained from the pointer.
-func NullDateFrom(ptr *Date) NullDate {
+// nullableTarget is implemented by pointers to the types that satisfy Nullable.
+type nullableTarget interface {
+	encoding.BinaryUnmarshaler
+	encoding.TextUnmarshaler
+	json.Unmarshaler
+	Scan(src interface{}) error
+}
+
+// Null represents a value of one of the local types that may be null.
+// Null implements the sql Scanner interface so it can be used as a scan
+// destination, similar to sql.Null.
+//
+// A null value is marshalled as JSON null, as empty text and as
+// empty binary data. When marshalled as XML a null value is omitted,
+// and an empty element or an element with xsi:nil="true" is unmarshalled
+// as null.
+type Null[T Nullable] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// NullFrom returns a Null whose value is obtained from the pointer.
+func NullFrom[T Nullable](ptr *T) Null[T] {
 	if ptr == nil {
-		return NullDate{}
-	}
-	return NullDate{
-		Date:  *ptr,
-		Valid: true,
+		return Null[T]{}
 	}
+	return Null[T]{V: *ptr, Valid: true}
 }
 
-// Ptr returns a pointer to Date. The pointer will
+// NullFor returns a valid Null containing v.
+func NullFor[T Nullable](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// Ptr returns a pointer to the value. The pointer will
 // be nil if Valid is false.
-func (n NullDate) Ptr() *Date {
+func (n Null[T]) Ptr() *T {
 	if n.Valid {
-		v := n.Date
+		v := n.V
 		return &v
 	}
 	return nil
 }
 
-// Scan implements the sql Scanner interface
-func (n *NullDate) Scan(value interface{}) error {
+// IsZero reports whether n is null. A valid Null containing the zero
+// value of T is not zero.
+func (n Null[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// set sets the value of n if err is nil.
+func (n *Null[T]) set(v T, err error) error {
+	if err == nil {
+		n.V, n.Valid = v, true
+	}
+	return err
+}
+
+// target returns the unmarshalling methods of a pointer to v.
+func target[T Nullable](v *T) nullableTarget {
+	return any(v).(nullableTarget)
+}
+
+// Scan implements the sql Scanner interface.
+func (n *Null[T]) Scan(value interface{}) error {
 	if n == nil {
 		return errNilPtr
 	}
-
 	if value == nil {
-		n.Date, n.Valid = Date{}, false
+		*n = Null[T]{}
 		return nil
 	}
-
-	err := n.Date.Scan(value)
-	if err == nil {
-		n.Valid = true
-	}
-
-	return err
+	var v T
+	return n.set(v, target(&v).Scan(value))
 }
 
 // Value implements the driver Valuer interface.
-func (n NullDate) Value() (driver.Value, error) {
+func (n Null[T]) Value() (driver.Value, error) {
 	if !n.Valid {
 		return nil, nil
 	}
-	return n.Date.Value()
+	return n.V.Value()
 }
 
 var (
@@ -71,33 +112,265 @@ var (
 )
 
 // MarshalJSON implements the json.Marshaler interface.
-func (n NullDate) MarshalJSON() ([]byte, error) {
+func (n Null[T]) MarshalJSON() ([]byte, error) {
 	if n.Valid {
-		return n.Date.MarshalJSON()
+		return n.V.MarshalJSON()
 	}
 	return nullText, nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-func (n *NullDate) UnmarshalJSON(p []byte) error {
+func (n *Null[T]) UnmarshalJSON(p []byte) error {
 	if bytes.Equal(p, nullText) {
-		n.Valid = false
-		n.Date = Date{}
+		*n = Null[T]{}
 		return nil
 	}
-	var d Date
-	if err := d.UnmarshalJSON(p); err != nil {
+	var v T
+	return n.set(v, target(&v).UnmarshalJSON(p))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// A null value is marshalled as empty text.
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if n.Valid {
+		return n.V.MarshalText()
+	}
+	return []byte{}, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is unmarshalled as null.
+func (n *Null[T]) UnmarshalText(p []byte) error {
+	if len(p) == 0 {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	return n.set(v, target(&v).UnmarshalText(p))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// A null value is marshalled as empty data.
+func (n Null[T]) MarshalBinary() ([]byte, error) {
+	if n.Valid {
+		return n.V.MarshalBinary()
+	}
+	return []byte{}, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Empty data is unmarshalled as null.
+func (n *Null[T]) UnmarshalBinary(p []byte) error {
+	if len(p) == 0 {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	return n.set(v, target(&v).UnmarshalBinary(p))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// A null value is omitted.
+func (n Null[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.Valid {
+		return nil
+	}
+	return e.EncodeElement(n.V, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// An empty element, or an element with the attribute xsi:nil="true",
+// is unmarshalled as null.
+func (n *Null[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
 		return err
 	}
-	n.Valid = true
-	n.Date = d
-	return nil
+	s = strings.TrimSpace(s)
+	if s == "" || isXMLNil(start) {
+		*n = Null[T]{}
+		return nil
+	}
+	return n.unmarshalXMLText(s)
+}
+
+// unmarshalXMLText sets n from the text of an XML element or attribute, using
+// the XML attribute unmarshalling of T if it has one, and text unmarshalling
+// otherwise.
+func (n *Null[T]) unmarshalXMLText(s string) error {
+	var v T
+	if u, ok := any(&v).(xml.UnmarshalerAttr); ok {
+		return n.set(v, u.UnmarshalXMLAttr(xml.Attr{Value: s}))
+	}
+	return n.set(v, target(&v).UnmarshalText([]byte(s)))
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// A null value is omitted.
+func (n Null[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !n.Valid {
+		return xml.Attr{}, nil
+	}
+	if m, ok := any(n.V).(xml.MarshalerAttr); ok {
+		return m.MarshalXMLAttr(name)
+	}
+	text, err := n.V.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// An empty attribute is unmarshalled as null.
+func (n *Null[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" {
+		*n = Null[T]{}
+		return nil
+	}
+	return n.unmarshalXMLText(attr.Value)
+}
+
+// xsiNamespace is the XML Schema instance namespace, which is usually
+// bound to the prefix xsi.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// isXMLNil reports whether the element has the attribute xsi:nil="true".
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Space == xsiNamespace && attr.Name.Local == "nil" && strings.TrimSpace(attr.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// NullDate represents a Date that may be null.
+// NullDate implements the sql Scanner interface so
+// it can be used as a scan destination, similar to
+// sql.NullString.
+//
+// NullDate predates the generic Null type, and behaves
+// in the same way as Null[Date].
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NullDateFrom returns a NullDate whose value is
+// obtained from the pointer.
+func NullDateFrom(ptr *Date) NullDate {
+	return nullDate(NullFrom(ptr))
+}
+
+// Null returns n as a Null[Date].
+func (n NullDate) Null() Null[Date] {
+	return Null[Date]{V: n.Date, Valid: n.Valid}
+}
+
+// nullDate returns n as a NullDate.
+func nullDate(n Null[Date]) NullDate {
+	return NullDate{Date: n.V, Valid: n.Valid}
+}
+
+// update calls fn with n as a Null[Date] and stores the result in n.
+func (n *NullDate) update(fn func(*Null[Date]) error) error {
+	if n == nil {
+		return errNilPtr
+	}
+	v := n.Null()
+	err := fn(&v)
+	*n = nullDate(v)
+	return err
+}
+
+// Ptr returns a pointer to Date. The pointer will
+// be nil if Valid is false.
+func (n NullDate) Ptr() *Date {
+	return n.Null().Ptr()
+}
+
+// IsZero reports whether n is null.
+func (n NullDate) IsZero() bool {
+	return n.Null().IsZero()
+}
+
+// Scan implements the sql Scanner interface
+func (n *NullDate) Scan(value interface{}) error {
+	return n.update(func(v *Null[Date]) error { return v.Scan(value) })
+}
+
+// Value implements the driver Valuer interface.
+func (n NullDate) Value() (driver.Value, error) {
+	return n.Null().Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	return n.Null().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullDate) UnmarshalJSON(p []byte) error {
+	return n.update(func(v *Null[Date]) error { return v.UnmarshalJSON(p) })
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// A null value is marshalled as empty text.
+func (n NullDate) MarshalText() ([]byte, error) {
+	return n.Null().MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is unmarshalled as null.
+func (n *NullDate) UnmarshalText(p []byte) error {
+	return n.update(func(v *Null[Date]) error { return v.UnmarshalText(p) })
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// A null value is marshalled as empty data.
+func (n NullDate) MarshalBinary() ([]byte, error) {
+	return n.Null().MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Empty data is unmarshalled as null.
+func (n *NullDate) UnmarshalBinary(p []byte) error {
+	return n.update(func(v *Null[Date]) error { return v.UnmarshalBinary(p) })
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// A null value is omitted.
+func (n NullDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return n.Null().MarshalXML(e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// An empty element, or an element with the attribute xsi:nil="true",
+// is unmarshalled as null.
+func (n *NullDate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return n.update(func(v *Null[Date]) error { return v.UnmarshalXML(d, start) })
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// A null value is omitted.
+func (n NullDate) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return n.Null().MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// An empty attribute is unmarshalled as null.
+func (n *NullDate) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.update(func(v *Null[Date]) error { return v.UnmarshalXMLAttr(attr) })
 }
 
 // NullDateTime represents a DateTime that may be null.
 // NullDateTime implements the sql Scanner interface so
 // it can be used as a scan destination, similar to
 // sql.NullString.
+//
+// NullDateTime predates the generic Null type, and behaves
+// in the same way as Null[DateTime].
 type NullDateTime struct {
 	DateTime DateTime
 	Valid    bool // Valid is true if Date is not NULL
@@ -106,72 +379,106 @@ type NullDateTime struct {
 // NullDateTimeFrom returns a NullDateTime whose value is
 // obtained from the pointer.
 func NullDateTimeFrom(ptr *DateTime) NullDateTime {
-	if ptr == nil {
-		return NullDateTime{}
-	}
-	return NullDateTime{
-		DateTime: *ptr,
-		Valid:    true,
-	}
+	return nullDateTime(NullFrom(ptr))
 }
 
-// Ptr returns a pointer to DateTime. The pointer will
-// be nil if Valid is false.
-func (n NullDateTime) Ptr() *DateTime {
-	if n.Valid {
-		v := n.DateTime
-		return &v
-	}
-	return nil
+// Null returns n as a Null[DateTime].
+func (n NullDateTime) Null() Null[DateTime] {
+	return Null[DateTime]{V: n.DateTime, Valid: n.Valid}
 }
 
-// Scan implements the sql Scanner interface
-func (n *NullDateTime) Scan(value interface{}) error {
+// nullDateTime returns n as a NullDateTime.
+func nullDateTime(n Null[DateTime]) NullDateTime {
+	return NullDateTime{DateTime: n.V, Valid: n.Valid}
+}
+
+// update calls fn with n as a Null[DateTime] and stores the result in n.
+func (n *NullDateTime) update(fn func(*Null[DateTime]) error) error {
 	if n == nil {
 		return errNilPtr
 	}
+	v := n.Null()
+	err := fn(&v)
+	*n = nullDateTime(v)
+	return err
+}
 
-	if value == nil {
-		n.DateTime, n.Valid = DateTime{}, false
-		return nil
-	}
+// Ptr returns a pointer to DateTime. The pointer will
+// be nil if Valid is false.
+func (n NullDateTime) Ptr() *DateTime {
+	return n.Null().Ptr()
+}
 
-	err := n.DateTime.Scan(value)
-	if err == nil {
-		n.Valid = true
-	}
+// IsZero reports whether n is null.
+func (n NullDateTime) IsZero() bool {
+	return n.Null().IsZero()
+}
 
-	return err
+// Scan implements the sql Scanner interface
+func (n *NullDateTime) Scan(value interface{}) error {
+	return n.update(func(v *Null[DateTime]) error { return v.Scan(value) })
 }
 
 // Value implements the driver Valuer interface.
 func (n NullDateTime) Value() (driver.Value, error) {
-	if !n.Valid {
-		return nil, nil
-	}
-	return n.DateTime.Value()
+	return n.Null().Value()
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 func (n NullDateTime) MarshalJSON() ([]byte, error) {
-	if n.Valid {
-		return n.DateTime.MarshalJSON()
-	}
-	return nullText, nil
+	return n.Null().MarshalJSON()
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (n *NullDateTime) UnmarshalJSON(p []byte) error {
-	if bytes.Equal(p, nullText) {
-		n.Valid = false
-		n.DateTime = DateTime{}
-		return nil
-	}
-	var d DateTime
-	if err := d.UnmarshalJSON(p); err != nil {
-		return err
-	}
-	n.Valid = true
-	n.DateTime = d
-	return nil
+	return n.update(func(v *Null[DateTime]) error { return v.UnmarshalJSON(p) })
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// A null value is marshalled as empty text.
+func (n NullDateTime) MarshalText() ([]byte, error) {
+	return n.Null().MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is unmarshalled as null.
+func (n *NullDateTime) UnmarshalText(p []byte) error {
+	return n.update(func(v *Null[DateTime]) error { return v.UnmarshalText(p) })
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// A null value is marshalled as empty data.
+func (n NullDateTime) MarshalBinary() ([]byte, error) {
+	return n.Null().MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Empty data is unmarshalled as null.
+func (n *NullDateTime) UnmarshalBinary(p []byte) error {
+	return n.update(func(v *Null[DateTime]) error { return v.UnmarshalBinary(p) })
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// A null value is omitted.
+func (n NullDateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return n.Null().MarshalXML(e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// An empty element, or an element with the attribute xsi:nil="true",
+// is unmarshalled as null.
+func (n *NullDateTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return n.update(func(v *Null[DateTime]) error { return v.UnmarshalXML(d, start) })
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// A null value is omitted.
+func (n NullDateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return n.Null().MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// An empty attribute is unmarshalled as null.
+func (n *NullDateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.update(func(v *Null[DateTime]) error { return v.UnmarshalXMLAttr(attr) })
 }
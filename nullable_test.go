@@ -2,6 +2,7 @@ package local
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"strings"
 	"testing"
 	"time"
@@ -280,3 +281,252 @@ func TestNullDateTimeJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestNullJSON(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		Date      Null[Date]      `json:"date"`
+		YearMonth Null[YearMonth] `json:"yearMonth"`
+		ISOWeek   Null[ISOWeek]   `json:"isoWeek"`
+	}
+	v := testStruct{
+		Date:      NullFor(DateFor(2025, 9, 30)),
+		YearMonth: NullFor(YearMonthFor(2025, 9)),
+	}
+	data, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"date":"2025-09-30","yearMonth":"2025-09","isoWeek":null}`, string(data))
+
+	var v2 testStruct
+	v2.ISOWeek = NullFor(ISOWeekFor(2025, 40))
+	assert.NoError(json.Unmarshal(data, &v2))
+	assert.Equal(v, v2)
+
+	assert.Error(json.Unmarshal([]byte(`{"date":"xxx"}`), &v2))
+	assert.Equal(v.Date, v2.Date)
+}
+
+func TestNullText(t *testing.T) {
+	assert := assert.New(t)
+	n := NullFor(MonthDayFor(2, 29))
+	text, err := n.MarshalText()
+	assert.NoError(err)
+	assert.Equal("--02-29", string(text))
+
+	var n2 Null[MonthDay]
+	assert.NoError(n2.UnmarshalText(text))
+	assert.Equal(n, n2)
+	assert.NoError(n2.UnmarshalText(nil))
+	assert.False(n2.Valid)
+
+	text, err = n2.MarshalText()
+	assert.NoError(err)
+	assert.Equal("", string(text))
+	assert.Error(n2.UnmarshalText([]byte("xxx")))
+	assert.False(n2.Valid)
+}
+
+func TestNullBinary(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []interface {
+		MarshalBinary() ([]byte, error)
+	}{
+		NullFor(DateFor(2025, 9, 30)),
+		NullFor(DateTimeForNano(2025, 9, 30, 15, 4, 5, 123)),
+		NullFor(YearMonthFor(-44, 3)),
+		NullFor(MonthDayFor(12, 25)),
+		NullFor(ISOWeekFor(2026, 53)),
+		Null[Date]{},
+	}
+	for _, tc := range testCases {
+		data, err := tc.MarshalBinary()
+		assert.NoError(err)
+		switch n := tc.(type) {
+		case Null[Date]:
+			var n2 Null[Date]
+			assert.NoError(n2.UnmarshalBinary(data))
+			assert.Equal(n, n2)
+			assert.Equal(!n.Valid, len(data) == 0)
+		case Null[DateTime]:
+			var n2 Null[DateTime]
+			assert.NoError(n2.UnmarshalBinary(data))
+			assert.Equal(n, n2)
+		case Null[YearMonth]:
+			var n2 Null[YearMonth]
+			assert.NoError(n2.UnmarshalBinary(data))
+			assert.Equal(n, n2)
+		case Null[MonthDay]:
+			var n2 Null[MonthDay]
+			assert.NoError(n2.UnmarshalBinary(data))
+			assert.Equal(n, n2)
+		case Null[ISOWeek]:
+			var n2 Null[ISOWeek]
+			assert.NoError(n2.UnmarshalBinary(data))
+			assert.Equal(n, n2)
+		}
+	}
+
+	var ym YearMonth
+	assert.Error(ym.UnmarshalBinary([]byte{0, 0, 7, 0xe9, 13}))
+	var w ISOWeek
+	assert.Error(w.UnmarshalBinary([]byte{0, 0, 7, 0xe9, 53}))
+}
+
+func TestNullXML(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		XMLName xml.Name        `xml:"test"`
+		Start   Null[Date]      `xml:"start,attr"`
+		End     Null[Date]      `xml:"end,attr"`
+		Due     Null[DateTime]  `xml:"due"`
+		Period  Null[YearMonth] `xml:"period"`
+		Week    Null[ISOWeek]   `xml:"week"`
+	}
+	v := testStruct{
+		Start: NullFor(DateFor(2025, 9, 1)),
+		Due:   NullFor(DateTimeFor(2025, 9, 30, 17, 0, 0)),
+	}
+	data, err := xml.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`<test start="2025-09-01"><due>2025-09-30T17:00:00</due></test>`, string(data))
+
+	var v2 testStruct
+	assert.NoError(xml.Unmarshal(data, &v2))
+	v2.XMLName = xml.Name{}
+	assert.Equal(v, v2)
+
+	input := `<test xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" end="">` +
+		`<due xsi:nil="true"/><period>2025-09</period><week> </week></test>`
+	v2 = testStruct{Due: v.Due, Week: NullFor(ISOWeekFor(2025, 1))}
+	assert.NoError(xml.Unmarshal([]byte(input), &v2))
+	assert.False(v2.End.Valid)
+	assert.False(v2.Due.Valid)
+	assert.Equal(NullFor(YearMonthFor(2025, 9)), v2.Period)
+	assert.False(v2.Week.Valid)
+
+	// a nil attribute in another namespace is not xsi:nil
+	input = `<test xmlns:x="urn:example"><due x:nil="true">2025-09-30T17:00:00</due></test>`
+	assert.NoError(xml.Unmarshal([]byte(input), &v2))
+	assert.Equal(v.Due, v2.Due)
+
+	assert.Error(xml.Unmarshal([]byte(`<test><due>xxx</due></test>`), &v2))
+}
+
+func TestNullScanValue(t *testing.T) {
+	assert := assert.New(t)
+	var n Null[YearMonth]
+	assert.NoError(n.Scan("2025-09-30"))
+	assert.Equal(NullFor(YearMonthFor(2025, 9)), n)
+	value, err := n.Value()
+	assert.NoError(err)
+	assert.Equal(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), value)
+
+	assert.Error(n.Scan(24))
+	assert.True(n.Valid)
+	assert.NoError(n.Scan(nil))
+	assert.False(n.Valid)
+	value, err = n.Value()
+	assert.NoError(err)
+	assert.Nil(value)
+
+	var np *Null[Date]
+	assert.Equal(errNilPtr, np.Scan(nil))
+}
+
+func TestNullPtr(t *testing.T) {
+	assert := assert.New(t)
+	d := DateFor(2025, 9, 30)
+	n := NullFrom(&d)
+	assert.True(n.Valid)
+	assert.False(n.IsZero())
+	assert.Equal(d, *n.Ptr())
+	assert.True(n.Ptr() != &d)
+
+	n = NullFrom[Date](nil)
+	assert.True(n.IsZero())
+	assert.Nil(n.Ptr())
+
+	assert.True(NullFor(Date{}).Valid)
+	assert.False(NullFor(Date{}).IsZero())
+
+	nd := NullDate{Date: d, Valid: true}
+	assert.Equal(NullFor(d), nd.Null())
+	ndt := NullDateTimeFrom(nil)
+	assert.Equal(Null[DateTime]{}, ndt.Null())
+}
+
+func TestNullDateTextXML(t *testing.T) {
+	assert := assert.New(t)
+	type testStruct struct {
+		XMLName xml.Name     `xml:"test"`
+		From    NullDate     `xml:"from,attr"`
+		To      NullDate     `xml:"to,attr"`
+		Born    NullDate     `xml:"born"`
+		Died    NullDate     `xml:"died"`
+		Updated NullDateTime `xml:"updated"`
+		Deleted NullDateTime `xml:"deleted,attr"`
+	}
+	v := testStruct{
+		From:    NullDate{DateFor(2025, 1, 1), true},
+		Born:    NullDate{DateFor(1950, 6, 30), true},
+		Updated: NullDateTime{DateTimeFor(2025, 9, 30, 15, 4, 5), true},
+	}
+	data, err := xml.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`<test from="2025-01-01"><born>1950-06-30</born><updated>2025-09-30T15:04:05</updated></test>`, string(data))
+
+	var v2 testStruct
+	assert.NoError(xml.Unmarshal(data, &v2))
+	v2.XMLName = xml.Name{}
+	assert.Equal(v, v2)
+
+	v2.Died = NullDate{DateFor(2020, 1, 1), true}
+	assert.NoError(xml.Unmarshal([]byte(`<test to=""><died></died></test>`), &v2))
+	assert.False(v2.To.Valid)
+	assert.False(v2.Died.Valid)
+	assert.Error(xml.Unmarshal([]byte(`<test deleted="xxx"></test>`), &v2))
+
+	// text marshalling allows use as JSON map keys
+	m := map[NullDate]int{{}: 1, {DateFor(2025, 9, 30), true}: 2}
+	data, err = json.Marshal(m)
+	assert.NoError(err)
+	assert.Equal(`{"":1,"2025-09-30":2}`, string(data))
+	var m2 map[NullDate]int
+	assert.NoError(json.Unmarshal(data, &m2))
+	assert.Equal(m, m2)
+
+	var ndt NullDateTime
+	assert.NoError(ndt.UnmarshalText([]byte("2025-09-30T15:04:05")))
+	assert.Equal(NullDateTime{DateTimeFor(2025, 9, 30, 15, 4, 5), true}, ndt)
+	text, err := ndt.MarshalText()
+	assert.NoError(err)
+	assert.Equal("2025-09-30T15:04:05", string(text))
+	assert.NoError(ndt.UnmarshalText([]byte{}))
+	assert.Equal(NullDateTime{}, ndt)
+}
+
+func TestNullDateBinary(t *testing.T) {
+	assert := assert.New(t)
+	for _, nd := range []NullDate{{}, {DateFor(2025, 9, 30), true}} {
+		assert.Equal(!nd.Valid, nd.IsZero())
+		data, err := nd.MarshalBinary()
+		assert.NoError(err)
+		expected, _ := nd.Null().MarshalBinary()
+		assert.Equal(expected, data)
+		nd2 := NullDate{DateFor(2000, 1, 1), true}
+		assert.NoError(nd2.UnmarshalBinary(data))
+		assert.Equal(nd, nd2)
+	}
+
+	for _, ndt := range []NullDateTime{{}, {DateTimeForNano(2025, 9, 30, 15, 4, 5, 123), true}} {
+		assert.Equal(!ndt.Valid, ndt.IsZero())
+		data, err := ndt.MarshalBinary()
+		assert.NoError(err)
+		ndt2 := NullDateTime{DateTimeFor(2000, 1, 1, 0, 0, 0), true}
+		assert.NoError(ndt2.UnmarshalBinary(data))
+		assert.Equal(ndt, ndt2)
+	}
+
+	var nd *NullDate
+	assert.Error(nd.UnmarshalBinary(nil))
+}
@@ -11,6 +11,7 @@ import (
 var (
 	errInvalidDateFormat     = errors.New("invalid date format")
 	errInvalidDateTimeFormat = errors.New("invalid date-time format")
+	errDateTimeHasZone       = errors.New("date-time has a timezone")
 )
 
 var parseFormats = struct {
@@ -51,6 +52,7 @@ var parseRegexp = struct {
 const (
 	startRE = `^\s*`
 	endRE   = `\s*$`
+	zoneRE  = `(?P<zone>[Zz]|[+-]\d{2}(?::?\d{2})?)?`
 )
 
 func init() {
@@ -64,9 +66,9 @@ func init() {
 		parseRegexp.calendarDateTimes = append(parseRegexp.calendarDateTimes, regexp.MustCompile(text))
 
 		for _, tod := range parseFormats.times {
-			text = startRE + cd + "T" + tod + endRE
+			text = startRE + cd + "T" + tod + zoneRE + endRE
 			parseRegexp.calendarDateTimes = append(parseRegexp.calendarDateTimes, regexp.MustCompile(text))
-			text = startRE + cd + `\s+` + tod + endRE
+			text = startRE + cd + `\s+` + tod + zoneRE + endRE
 			parseRegexp.calendarDateTimes = append(parseRegexp.calendarDateTimes, regexp.MustCompile(text))
 		}
 	}
@@ -81,7 +83,7 @@ func init() {
 		parseRegexp.ordinalDateTimes = append(parseRegexp.ordinalDateTimes, regexp.MustCompile(text))
 
 		for _, tod := range parseFormats.times {
-			text = startRE + od + "T" + tod + endRE
+			text = startRE + od + "T" + tod + zoneRE + endRE
 			parseRegexp.ordinalDateTimes = append(parseRegexp.ordinalDateTimes, regexp.MustCompile(text))
 		}
 	}
@@ -106,6 +108,9 @@ func DateParseLayout(layout, value string) (Date, error) {
 //
 // DateParse is used to parse dates where no layout is provided, for example
 // when marshaling and unmarshaling JSON and XML.
+//
+// The date may be followed by a time and zone designator, which are discarded.
+// Use DateParseOffset or DateParseIn to take the zone designator into account.
 func DateParse(s string) (Date, error) {
 	s = strings.Trim(s, " \t\"'")
 	for _, regexp := range parseRegexp.calendarDates {
@@ -135,10 +140,38 @@ func DateParse(s string) (Date, error) {
 	return Date{}, errInvalidDateFormat
 }
 
+// DateParseOffset parses a string into a local date in the same way as
+// DateTimeParseOffset. The date is returned exactly as written, along with
+// a fixed location that describes the zone offset. If s does not contain a
+// zone designator, the returned location is nil.
+func DateParseOffset(s string) (Date, *time.Location, error) {
+	dt, loc, err := DateTimeParseOffset(s)
+	if err != nil {
+		return Date{}, nil, errInvalidDateFormat
+	}
+	return dt.date(), loc, nil
+}
+
+// DateParseIn parses a string in the same formats as DateParseOffset.
+// If s contains a zone designator, the instant in time that it describes
+// is converted to the wall time in loc before the date is taken. If s does
+// not contain a zone designator, the date is returned as written.
+//
+// DateParseIn panics if loc is nil.
+func DateParseIn(s string, loc *time.Location) (Date, error) {
+	dt, err := DateTimeParseIn(s, loc)
+	if err != nil {
+		return Date{}, errInvalidDateFormat
+	}
+	return dt.date(), nil
+}
+
 // DateTimeParseLayout parses a formatted string and returns the date value it represents.
 // The layout is based on the standard library time package and for local date-times the reference is
 //  Mon Jan 2 2006 15:04:05
-// If the layout contains a timezone field, it is parsed and discarded.
+// If the layout contains a timezone field, it is parsed and discarded. To reject a value
+// that contains a timezone, use DateTimeParseLayoutStrict. To convert the value into the
+// wall time of a location, use DateTimeParseLayoutIn.
 func DateTimeParseLayout(layout, value string) (DateTime, error) {
 	t, err := time.Parse(layout, value)
 	if err != nil {
@@ -147,16 +180,116 @@ func DateTimeParseLayout(layout, value string) (DateTime, error) {
 	return DateTimeFromTime(t), nil
 }
 
+// DateTimeParseLayoutStrict parses a formatted string in the same way as DateTimeParseLayout,
+// except that a value that contains a timezone is rejected.
+func DateTimeParseLayoutStrict(layout, value string) (DateTime, error) {
+	t, err := time.ParseInLocation(layout, value, layoutZones[0])
+	if err != nil {
+		return DateTime{}, err
+	}
+	if t.Location() != layoutZones[0] {
+		return DateTime{}, errDateTimeHasZone
+	}
+	// A numeric offset equal to the offset of layoutZones[0] is detected by
+	// parsing again with a location that has a different offset.
+	if t2, _ := time.ParseInLocation(layout, value, layoutZones[1]); t2.Location() != layoutZones[1] {
+		return DateTime{}, errDateTimeHasZone
+	}
+	return DateTimeFromTime(t), nil
+}
+
+// layoutZones are the locations used by DateTimeParseLayoutStrict to detect a
+// value that contains a timezone. time.ParseInLocation returns a time in the
+// location passed to it only if the value has no timezone, or if the value has
+// a numeric offset equal to the offset of the location. "Z" and "UTC" are
+// returned as UTC, and other zone abbreviations never match the empty names
+// of these zones. So the location is returned for both zones, whose offsets
+// differ, only when the value has no timezone.
+var layoutZones = [2]*time.Location{
+	time.FixedZone("", 0),
+	time.FixedZone("", 3600),
+}
+
+// DateTimeParseLayoutIn parses a formatted string in the same way as DateTimeParseLayout.
+// If the layout contains a timezone field, the instant in time described by the value
+// is converted to the wall time in loc. Otherwise the date-time is returned as written.
+//
+// DateTimeParseLayoutIn panics if loc is nil.
+func DateTimeParseLayoutIn(layout, value string, loc *time.Location) (DateTime, error) {
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTimeFromTime(t.In(loc)), nil
+}
+
 // DateTimeParse attempts to parse a string into a local date-time. Leading
 // and trailing space and quotation marks are ignored. The following
 // date formates are recognized: yyyy-mm-dd, yyyymmdd, yyyy.mm.dd,
 // yyyy/mm/dd, yyyy-ddd, yyyyddd. The following time formats are recognized:
-// HH:MM:SS, HH:MM, HHMMSS, HHMM.
+// HH:MM:SS, HH:MM, HHMMSS, HHMM. Seconds may be followed by a decimal
+// fraction, which is kept to DateTimePrecision.
+//
+// A string containing a zone designator is rejected. Use DateTimeParseOffset
+// or DateTimeParseIn to parse strings that contain a zone designator.
 func DateTimeParse(s string) (DateTime, error) {
+	dt, zone, err := parseDateTime(s)
+	if err != nil {
+		return DateTime{}, err
+	}
+	if zone != "" {
+		return DateTime{}, errInvalidDateTimeFormat
+	}
+	return dt, nil
+}
+
+// DateTimeParseOffset parses a string into a local date-time in the same way
+// as DateTimeParse, except that the time may be followed by a zone designator:
+// Z, ±hh:mm, ±hhmm or ±hh. The date-time is returned exactly as written,
+// along with a fixed location that describes the zone offset. If s does not
+// contain a zone designator, the returned location is nil.
+func DateTimeParseOffset(s string) (DateTime, *time.Location, error) {
+	dt, zone, err := parseDateTime(s)
+	if err != nil {
+		return DateTime{}, nil, err
+	}
+	if zone == "" {
+		return dt, nil, nil
+	}
+	loc, err := parseZone(zone)
+	if err != nil {
+		return DateTime{}, nil, err
+	}
+	return dt, loc, nil
+}
+
+// DateTimeParseIn parses a string in the same formats as DateTimeParseOffset.
+// If s contains a zone designator, the instant in time that it describes
+// is converted to the wall time in loc. If s does not contain a zone designator,
+// the date-time is returned as written.
+//
+// DateTimeParseIn panics if loc is nil.
+func DateTimeParseIn(s string, loc *time.Location) (DateTime, error) {
+	dt, offset, err := DateTimeParseOffset(s)
+	if err != nil || offset == nil {
+		return dt, err
+	}
+	year, month, day, hour, minute, second := dt.DateTime()
+	t := time.Date(year, month, day, hour, minute, second, dt.Nanosecond(), offset)
+	return DateTimeFromTime(t.In(loc)), nil
+}
+
+// parseDateTime parses a string into a local date-time, returning
+// any zone designator that follows the time.
+func parseDateTime(s string) (dt DateTime, zone string, err error) {
 	s = strings.Trim(s, " \t\"'")
 	for _, regexp := range parseRegexp.calendarDateTimes {
 		match := regexp.FindStringSubmatch(s)
 		if match != nil {
+			if i := regexp.SubexpIndex("zone"); i > 0 {
+				zone, match = match[i], match[:i]
+			}
+
 			// no error checking here because matching the regexp
 			// guarantees that parsing the strings will succeed.
 			year, _ := strconv.ParseInt(match[1], 10, 0)
@@ -173,14 +306,23 @@ func DateTimeParse(s string) (DateTime, error) {
 			if len(match) > 6 {
 				second, _ = strconv.ParseInt(match[6], 10, 0)
 			}
+			var nanosecond int
+			if len(match) > 7 {
+				nanosecond = parseFraction(match[7])
+			}
 
-			return DateTimeFor(int(year), time.Month(month), int(day), int(hour), int(minute), int(second)), nil
+			dt = DateTimeForNano(int(year), time.Month(month), int(day), int(hour), int(minute), int(second), nanosecond)
+			return dt.Truncate(DateTimePrecision), zone, nil
 		}
 	}
 
 	for _, regexp := range parseRegexp.ordinalDateTimes {
 		match := regexp.FindStringSubmatch(s)
 		if match != nil {
+			if i := regexp.SubexpIndex("zone"); i > 0 {
+				zone, match = match[i], match[:i]
+			}
+
 			// no error checking here because matching the regexp
 			// guarantees that parsing the strings will succeed.
 			year, _ := strconv.ParseInt(match[1], 10, 0)
@@ -196,11 +338,49 @@ func DateTimeParse(s string) (DateTime, error) {
 			if len(match) > 5 {
 				second, _ = strconv.ParseInt(match[5], 10, 0)
 			}
+			var nanosecond int
+			if len(match) > 6 {
+				nanosecond = parseFraction(match[6])
+			}
 
 			duration := time.Duration((dayOfYear - 1) * nanosecondsPerDay)
-			return DateTimeFor(int(year), 1, 1, int(hour), int(minute), int(second)).Add(duration), nil
+			dt = DateTimeForNano(int(year), 1, 1, int(hour), int(minute), int(second), nanosecond).Add(duration)
+			return dt.Truncate(DateTimePrecision), zone, nil
 		}
 	}
 
-	return DateTime{}, errInvalidDateTimeFormat
+	return DateTime{}, "", errInvalidDateTimeFormat
+}
+
+// parseFraction returns the number of nanoseconds in a decimal
+// fraction of a second, such as ".123". Digits beyond nanosecond
+// precision are ignored.
+func parseFraction(fraction string) int {
+	digits := strings.TrimPrefix(fraction, ".")
+	if len(digits) > 9 {
+		digits = digits[:9]
+	}
+	nanosecond, _ := strconv.Atoi(digits + strings.Repeat("0", 9-len(digits)))
+	return nanosecond
+}
+
+// parseZone returns a fixed location for a zone designator.
+func parseZone(zone string) (*time.Location, error) {
+	if zone == "Z" || zone == "z" {
+		return time.UTC, nil
+	}
+	digits := strings.Replace(zone[1:], ":", "", 1)
+	hours, _ := strconv.Atoi(digits[:2])
+	var minutes int
+	if len(digits) > 2 {
+		minutes, _ = strconv.Atoi(digits[2:])
+	}
+	if hours > 23 || minutes > 59 {
+		return nil, errInvalidDateTimeFormat
+	}
+	offset := hours*3600 + minutes*60
+	if zone[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone("", offset), nil
 }
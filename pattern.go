@@ -0,0 +1,560 @@
+package local
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatPattern returns a textual representation of d formatted according
+// to pattern, which uses the Unicode LDML date format syntax used by Java's
+// DateTimeFormatter and by JavaScript libraries (for example "yyyy-MM-dd" or
+// "EEE d MMM y"). Letters are pattern fields, and text enclosed in single quotes
+// is literal. The following fields are supported:
+//
+//	G      era (AD), GGGG for the full name (Anno Domini)
+//	y      year of era (2025), yy for two digits (25)
+//	u      extended year, where 1 BC is year 0
+//	Y      ISO 8601 week-based year
+//	Q q    quarter (3), QQ (03), QQQ (Q3), QQQQ (3rd quarter)
+//	M L    month (9), MM (09), MMM (Sep), MMMM (September), MMMMM (S)
+//	w      ISO 8601 week of the week-based year (1-53)
+//	d      day of the month (1-31)
+//	D      day of the year (1-366)
+//	F      day of the week in the month (1-5)
+//	E      weekday (Tue), EEEE (Tuesday), EEEEE (T)
+//	e c    ISO 8601 weekday number (2), or the weekday name when repeated 3 or more times
+//	a      AM or PM
+//	h H    hour (1-12, 0-23)
+//	K k    hour (0-11, 1-24)
+//	m s    minute, second
+//	S      fraction of a second, with as many digits as letters
+//	A      milliseconds in the day
+//	o      day of the month as an ordinal (1st); not part of LDML
+//
+// Numeric fields are padded with zeros to the number of letters. Names are in
+// English; use Locale.FormatDatePattern for other languages. Time fields are
+// formatted as midnight. Time zone fields and other letters that are not
+// supported are copied to the output unchanged.
+func (d Date) FormatPattern(pattern string) string {
+	return localeEnglish.FormatDatePattern(d, pattern)
+}
+
+// FormatPattern returns a textual representation of dt formatted according
+// to pattern, which uses the Unicode LDML date format syntax
+// (for example "yyyy-MM-dd'T'HH:mm:ss"). See Date.FormatPattern for
+// the supported fields.
+func (dt DateTime) FormatPattern(pattern string) string {
+	return localeEnglish.FormatDateTimePattern(dt, pattern)
+}
+
+// DateParsePattern parses value according to pattern, which uses the LDML
+// date format syntax described for Date.FormatPattern, and returns the date
+// it represents. Any time fields are parsed and discarded.
+//
+// The date is determined from the first of the following combinations present
+// in pattern: week-based year and week (Y w), with an optional weekday that
+// defaults to Monday; year and day of the year (y D); year, month and day
+// (y M d). A missing month or day defaults to 1, or to the first month
+// of the quarter (Q) if one is present. A weekday in the value must
+// agree with the date. A two digit year (yy) is interpreted as being within
+// 80 years before and 20 years after the current year.
+//
+// Literal text in the pattern must match the value exactly. Names are matched
+// in English, ignoring case. A numeric field that is immediately followed by
+// another numeric field, as in "yyyyMMdd", must have exactly as many digits
+// as pattern letters.
+func DateParsePattern(pattern, value string) (Date, error) {
+	return localeEnglish.ParseDatePattern(pattern, value)
+}
+
+// DateTimeParsePattern parses value according to pattern, which uses the
+// LDML date format syntax described for Date.FormatPattern, and returns the
+// date-time it represents. See DateParsePattern for details of how the date
+// is determined. Time fields that are not present in pattern are zero.
+func DateTimeParsePattern(pattern, value string) (DateTime, error) {
+	return localeEnglish.ParseDateTimePattern(pattern, value)
+}
+
+// patternToken is a field or a literal in an LDML date pattern.
+type patternToken struct {
+	field   byte // pattern letter, or zero for a literal
+	count   int  // number of times the pattern letter is repeated
+	literal string
+}
+
+// isPatternLetter reports whether c is reserved as a field in an LDML pattern.
+func isPatternLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// tokenizePattern splits an LDML date pattern into fields and literals.
+// Text enclosed in single quotes is literal, and two adjacent single
+// quotes represent a single quote. An unterminated quote extends to the
+// end of the pattern.
+func tokenizePattern(pattern string) []patternToken {
+	var tokens []patternToken
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, patternToken{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				literal.WriteByte('\'')
+				i += 2
+				continue
+			}
+			i++
+			for i < len(pattern) {
+				if pattern[i] == '\'' {
+					if i+1 < len(pattern) && pattern[i+1] == '\'' {
+						literal.WriteByte('\'')
+						i += 2
+						continue
+					}
+					break
+				}
+				literal.WriteByte(pattern[i])
+				i++
+			}
+			i++
+		case isPatternLetter(c):
+			flush()
+			j := i
+			for j < len(pattern) && pattern[j] == c {
+				j++
+			}
+			tokens = append(tokens, patternToken{field: c, count: j - i})
+			i = j
+		default:
+			literal.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return tokens
+}
+
+// formatPattern formats dt according to an LDML date pattern.
+func (l *Locale) formatPattern(pattern string, dt DateTime) string {
+	var b strings.Builder
+	for _, token := range tokenizePattern(pattern) {
+		if token.field == 0 {
+			b.WriteString(token.literal)
+		} else {
+			b.WriteString(l.formatField(token, dt))
+		}
+	}
+	return b.String()
+}
+
+// formatField formats a single field of an LDML date pattern. Pattern letters
+// that are not supported are written unchanged.
+func (l *Locale) formatField(token patternToken, dt DateTime) string {
+	year, month, day, hour, minute, second := dt.DateTime()
+	count := token.count
+	switch token.field {
+	case 'G':
+		era := 1
+		if year <= 0 {
+			era = 0
+		}
+		if count == 4 {
+			return l.wideEras[era]
+		}
+		return l.eras[era]
+	case 'y':
+		if count == 2 {
+			return l.number(yearOfEra(year)%100, 2)
+		}
+		return l.number(yearOfEra(year), count)
+	case 'u':
+		return l.number(year, count)
+	case 'Y':
+		isoYear, _ := dt.ISOWeek()
+		if count == 2 {
+			return l.number(floorMod(isoYear, 100), 2)
+		}
+		return l.number(isoYear, count)
+	case 'Q', 'q':
+		quarter := dt.Quarter()
+		switch {
+		case count <= 2:
+			return l.number(quarter, count)
+		case count == 3:
+			return l.shortQuarters[quarter-1]
+		case count == 4:
+			return l.quarters[quarter-1]
+		default:
+			return l.number(quarter, 1)
+		}
+	case 'M', 'L':
+		switch {
+		case count <= 2:
+			return l.number(int(month), count)
+		case count == 3:
+			return l.shortMonths[month-1]
+		case count == 4:
+			return l.months[month-1]
+		default:
+			return l.narrowMonths[month-1]
+		}
+	case 'd':
+		return l.number(day, count)
+	case 'o':
+		return l.Ordinal(day)
+	case 'D':
+		return l.number(dt.YearDay(), count)
+	case 'F':
+		return l.number((day-1)/7+1, count)
+	case 'w':
+		_, week := dt.ISOWeek()
+		return l.number(week, count)
+	case 'E':
+		return l.weekdayName(dt.Weekday(), count)
+	case 'e', 'c':
+		if count <= 2 {
+			return l.number(isoWeekday(dt.Weekday()), count)
+		}
+		return l.weekdayName(dt.Weekday(), count)
+	case 'a':
+		if hour < 12 {
+			return l.dayPeriods[0]
+		}
+		return l.dayPeriods[1]
+	case 'h':
+		h := hour % 12
+		if h == 0 {
+			h = 12
+		}
+		return l.number(h, count)
+	case 'H':
+		return l.number(hour, count)
+	case 'K':
+		return l.number(hour%12, count)
+	case 'k':
+		if hour == 0 {
+			return l.number(24, count)
+		}
+		return l.number(hour, count)
+	case 'm':
+		return l.number(minute, count)
+	case 's':
+		return l.number(second, count)
+	case 'S':
+		fraction := fmt.Sprintf("%09d", dt.Nanosecond())
+		if count <= len(fraction) {
+			fraction = fraction[:count]
+		} else {
+			fraction += strings.Repeat("0", count-len(fraction))
+		}
+		return l.localizeDigits(fraction)
+	case 'A':
+		ms := ((hour*60+minute)*60+second)*1000 + dt.Nanosecond()/int(time.Millisecond)
+		return l.number(ms, count)
+	}
+	return strings.Repeat(string(token.field), count)
+}
+
+// weekdayName returns the name of a weekday for an "E" field
+// with count letters.
+func (l *Locale) weekdayName(weekday time.Weekday, count int) string {
+	switch count {
+	case 4:
+		return l.days[weekday]
+	case 5:
+		return l.narrowDays[weekday]
+	default:
+		return l.shortDays[weekday]
+	}
+}
+
+// isoWeekday returns the ISO 8601 number of a weekday, where Monday is 1
+// and Sunday is 7.
+func isoWeekday(weekday time.Weekday) int {
+	return (int(weekday)+6)%7 + 1
+}
+
+// yearOfEra returns the year within the era for a proleptic Gregorian year.
+// Year 0 is 1 BC, year -1 is 2 BC, and so on.
+func yearOfEra(year int) int {
+	if year <= 0 {
+		return 1 - year
+	}
+	return year
+}
+
+// patternParser holds the fields parsed by Locale.parsePattern.
+type patternParser struct {
+	locale       *Locale
+	fields       map[byte]int
+	twoDigitYear bool
+}
+
+// parsePattern parses value according to an LDML date pattern.
+func (l *Locale) parsePattern(pattern, value string) (DateTime, error) {
+	p := patternParser{locale: l, fields: make(map[byte]int)}
+	if err := p.parse(pattern, l.delocalizeDigits(value)); err != nil {
+		return DateTime{}, fmt.Errorf("cannot parse %q as %q: %v", value, pattern, err)
+	}
+	dt, err := p.dateTime()
+	if err != nil {
+		return DateTime{}, fmt.Errorf("cannot parse %q as %q: %v", value, pattern, err)
+	}
+	return dt, nil
+}
+
+func (p *patternParser) parse(pattern, value string) error {
+	tokens := tokenizePattern(pattern)
+	pos := 0
+	for i, token := range tokens {
+		if token.field == 0 {
+			if !strings.HasPrefix(value[pos:], token.literal) {
+				return fmt.Errorf("expected %q", token.literal)
+			}
+			pos += len(token.literal)
+			continue
+		}
+		// a numeric field followed immediately by another numeric field,
+		// as in "yyyyMMdd", has exactly as many digits as pattern letters
+		adjacent := i+1 < len(tokens) && tokens[i+1].isNumeric()
+		n, err := p.parseField(token, value[pos:], adjacent)
+		if err != nil {
+			return err
+		}
+		pos += n
+	}
+	if pos != len(value) {
+		return fmt.Errorf("unexpected text %q", value[pos:])
+	}
+	return nil
+}
+
+// isNumeric reports whether the token is a field that is formatted as a number.
+func (token patternToken) isNumeric() bool {
+	switch token.field {
+	case 'y', 'u', 'Y', 'd', 'D', 'F', 'w', 'h', 'H', 'K', 'k', 'm', 's', 'S', 'A':
+		return true
+	case 'M', 'L', 'Q', 'q', 'e', 'c':
+		return token.count <= 2
+	}
+	return false
+}
+
+// parseField parses a single field of an LDML date pattern at the start
+// of value, returning the number of bytes parsed.
+func (p *patternParser) parseField(token patternToken, value string, adjacent bool) (int, error) {
+	l := p.locale
+	field, count := token.field, token.count
+	if !token.isNumeric() {
+		var n, index int
+		switch field {
+		case 'G':
+			n, index = matchName(value, l.wideEras[:], l.eras[:])
+		case 'M', 'L':
+			n, index = matchName(value, l.months[:], l.shortMonths[:])
+			index++
+		case 'Q', 'q':
+			n, index = matchName(value, l.quarters[:], l.shortQuarters[:])
+			index++
+		case 'E', 'e', 'c':
+			n, index = matchName(value, l.days[:], l.shortDays[:])
+			index = isoWeekday(time.Weekday(index))
+		case 'a':
+			n, index = matchName(value, l.dayPeriods[:])
+		case 'o':
+			return p.parseOrdinal(value)
+		default:
+			return 0, fmt.Errorf("unsupported field %q", strings.Repeat(string(field), count))
+		}
+		if n == 0 {
+			return 0, fmt.Errorf("expected %s", patternFieldNames[field])
+		}
+		p.fields[normalizeField(field)] = index
+		return n, nil
+	}
+
+	width := 2
+	switch field {
+	case 'y', 'u', 'Y', 'A', 'S':
+		width = 9
+	case 'D':
+		width = 3
+	}
+	if adjacent {
+		width = count
+	}
+	start := 0
+	if field == 'u' && strings.HasPrefix(value, "-") {
+		start = 1
+	}
+	digits := countDigits(value, start, width)
+	if digits == 0 || adjacent && digits != width {
+		return 0, fmt.Errorf("expected %s", patternFieldNames[field])
+	}
+	end := start + digits
+	if field == 'S' {
+		p.fields['S'] = parseFraction("." + value[:end])
+		return end, nil
+	}
+	n, _ := strconv.Atoi(value[:end])
+	p.fields[normalizeField(field)] = n
+	if (field == 'y' || field == 'Y') && count == 2 && digits == 2 {
+		p.twoDigitYear = true
+	}
+	return end, nil
+}
+
+// parseOrdinal parses a day of the month written as an ordinal number.
+func (p *patternParser) parseOrdinal(value string) (int, error) {
+	l := p.locale
+	digits := countDigits(value, 0, 2)
+	if digits > 0 {
+		n, _ := strconv.Atoi(value[:digits])
+		ordinal := l.delocalizeDigits(l.Ordinal(n))
+		if len(value) >= len(ordinal) && strings.EqualFold(value[:len(ordinal)], ordinal) {
+			p.fields['d'] = n
+			return len(ordinal), nil
+		}
+	}
+	return 0, fmt.Errorf("expected %s", patternFieldNames['o'])
+}
+
+// normalizeField returns the pattern letter used to store a parsed field,
+// so that fields with the same meaning are stored together.
+func normalizeField(field byte) byte {
+	switch field {
+	case 'L':
+		return 'M'
+	case 'q':
+		return 'Q'
+	case 'E', 'c':
+		return 'e'
+	}
+	return field
+}
+
+// patternFieldNames describes each pattern letter for use in error messages.
+var patternFieldNames = map[byte]string{
+	'G': "era", 'y': "year", 'u': "year", 'Y': "week-based year",
+	'Q': "quarter", 'q': "quarter", 'M': "month", 'L': "month",
+	'w': "week of year", 'd': "day of month", 'o': "day of month",
+	'D': "day of year", 'F': "day of week in month", 'E': "weekday",
+	'e': "weekday", 'c': "weekday", 'a': "day period", 'h': "hour",
+	'H': "hour", 'K': "hour", 'k': "hour", 'm': "minute", 's': "second",
+	'S': "fractional second", 'A': "milliseconds in day",
+}
+
+// dateTime returns the date-time described by the parsed fields.
+func (p *patternParser) dateTime() (DateTime, error) {
+	f := p.fields
+	has := func(fields ...byte) bool {
+		for _, c := range fields {
+			if _, ok := f[c]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	year, hasYear := f['u']
+	if !hasYear && has('y') {
+		year, hasYear = f['y'], true
+		if p.twoDigitYear {
+			year = p.locale.expandYear(dateToken{number: year, digits: 2})
+		}
+		if has('G') && f['G'] == 0 {
+			year = 1 - year
+		}
+	}
+
+	var d Date
+	switch {
+	case has('Y', 'w'):
+		isoYear := f['Y']
+		if p.twoDigitYear && !has('y') {
+			isoYear = p.locale.expandYear(dateToken{number: isoYear, digits: 2})
+		}
+		weekday, ok := f['e']
+		if !ok {
+			weekday = 1
+		}
+		if weekday < 1 || weekday > 7 {
+			return DateTime{}, fmt.Errorf("weekday out of range")
+		}
+		jan4 := DateFor(isoYear, 1, 4)
+		week1 := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7)
+		d = week1.AddDate(0, 0, 7*(f['w']-1)+weekday-1)
+		if y, w := d.ISOWeek(); y != isoYear || w != f['w'] {
+			return DateTime{}, fmt.Errorf("week out of range")
+		}
+	case hasYear && has('D'):
+		if f['D'] < 1 || f['D'] > DateFor(year, 12, 31).YearDay() {
+			return DateTime{}, fmt.Errorf("day of year out of range")
+		}
+		d = DateFor(year, 1, f['D'])
+	case hasYear:
+		month, ok := f['M']
+		if !ok && has('Q') {
+			month = (f['Q']-1)*3 + 1
+		} else if !ok {
+			month = 1
+		}
+		day, ok := f['d']
+		if !ok {
+			day = 1
+		}
+		if !isValidDate(year, month, day) {
+			return DateTime{}, fmt.Errorf("date out of range")
+		}
+		if has('Q') && (month-1)/3+1 != f['Q'] {
+			return DateTime{}, fmt.Errorf("month is not in quarter")
+		}
+		d = DateFor(year, time.Month(month), day)
+	default:
+		return DateTime{}, fmt.Errorf("missing year")
+	}
+	if weekday, ok := f['e']; ok && isoWeekday(d.Weekday()) != weekday {
+		return DateTime{}, fmt.Errorf("weekday does not match date")
+	}
+
+	hour, minute, second, nanosecond := f['H'], f['m'], f['s'], f['S']
+	switch {
+	case has('k'):
+		if f['k'] < 1 || f['k'] > 24 {
+			return DateTime{}, fmt.Errorf("hour out of range")
+		}
+		hour = f['k'] % 24
+	case has('h'):
+		if f['h'] < 1 || f['h'] > 12 {
+			return DateTime{}, fmt.Errorf("hour out of range")
+		}
+		hour = f['h']%12 + 12*f['a']
+	case has('K'):
+		if f['K'] > 11 {
+			return DateTime{}, fmt.Errorf("hour out of range")
+		}
+		hour = f['K'] + 12*f['a']
+	case has('A'):
+		ms := f['A']
+		if ms >= 24*60*60*1000 {
+			return DateTime{}, fmt.Errorf("milliseconds in day out of range")
+		}
+		hour, minute, second = ms/3600000, ms/60000%60, ms/1000%60
+		nanosecond = ms % 1000 * int(time.Millisecond)
+	}
+	if !isValidClock(hour, minute, second) {
+		return DateTime{}, fmt.Errorf("time out of range")
+	}
+
+	y, m, day := d.Date()
+	return DateTimeForNano(y, m, day, hour, minute, second, nanosecond).Truncate(DateTimePrecision), nil
+}
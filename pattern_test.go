@@ -0,0 +1,124 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatPattern(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		DateTime DateTime
+		Pattern  string
+		Expected string
+	}{
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), "yyyy-MM-dd'T'HH:mm:ss", "2025-09-30T15:04:05"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "EEE, d MMM yyyy", "Tue, 30 Sep 2025"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "QQQ yyyy, QQQQ, Q, qq", "Q3 2025, 3rd quarter, 3, 03"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "YYYY-'W'ww-e", "2025-W40-2"},
+		{DateTimeFor(2024, 12, 30, 0, 0, 0), "yyyy YYYY YY w", "2024 2025 25 1"},
+		{DateTimeFor(2023, 1, 1, 0, 0, 0), "Y w ee eeee", "2022 52 07 Sunday"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "G GGGG", "AD Anno Domini"},
+		{DateTimeFor(-43, 3, 15, 0, 0, 0), "y GGGG u", "44 Before Christ -43"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "F D DDD", "5 273 273"},
+		{DateTimeForNano(2025, 9, 30, 1, 2, 3, 4000000), "A", "3723004"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "yyyy-MM-dd zzz", "2025-09-30 zzz"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, tc.DateTime.FormatPattern(tc.Pattern), tc.Pattern)
+		year, month, day := tc.DateTime.Date()
+		if tc.DateTime.Equal(DateTimeFor(year, month, day, 0, 0, 0)) {
+			assert.Equal(tc.Expected, DateFor(year, month, day).FormatPattern(tc.Pattern), tc.Pattern)
+		}
+	}
+}
+
+func TestParsePattern(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Pattern  string
+		Value    string
+		Expected DateTime
+	}{
+		{"yyyy-MM-dd'T'HH:mm:ss", "2025-09-30T15:04:05", DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{"yyyyMMddHHmmss", "20250930150405", DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{"EEE, d MMM yyyy h:mm a", "tue, 30 Sep 2025 3:04 PM", DateTimeFor(2025, 9, 30, 15, 4, 0)},
+		{"EEEE d MMMM y", "Tuesday 30 September 2025", DateTimeFor(2025, 9, 30, 0, 0, 0)},
+		{"d/M/yy", "30/9/25", DateTimeFor(2025, 9, 30, 0, 0, 0)},
+		{"QQQ yyyy", "Q3 2025", DateTimeFor(2025, 7, 1, 0, 0, 0)},
+		{"QQQQ y", "4th quarter 2025", DateTimeFor(2025, 10, 1, 0, 0, 0)},
+		{"YYYY-'W'ww-e", "2025-W40-2", DateTimeFor(2025, 9, 30, 0, 0, 0)},
+		{"YYYY 'week' w", "2025 week 1", DateTimeFor(2024, 12, 30, 0, 0, 0)},
+		{"yyyy-DDD", "2025-273", DateTimeFor(2025, 9, 30, 0, 0, 0)},
+		{"d MMM y G", "15 Mar 44 BC", DateTimeFor(-43, 3, 15, 0, 0, 0)},
+		{"u-MM-dd", "-43-03-15", DateTimeFor(-43, 3, 15, 0, 0, 0)},
+		{"MMMM o, y", "September 23rd, 2025", DateTimeFor(2025, 9, 23, 0, 0, 0)},
+		{"yyyy-MM", "2025-09", DateTimeFor(2025, 9, 1, 0, 0, 0)},
+		{"yyyy-MM-dd HH:mm:ss.SSS", "2025-09-30 15:04:05.123", DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{"yyyy-MM-dd kk:mm", "2025-09-30 24:00", DateTimeFor(2025, 9, 30, 0, 0, 0)},
+		{"yyyy-MM-dd A", "2025-09-30 3723004", DateTimeFor(2025, 9, 30, 1, 2, 3)},
+	}
+
+	for _, tc := range testCases {
+		dt, err := DateTimeParsePattern(tc.Pattern, tc.Value)
+		if assert.NoError(err, tc.Value) {
+			assert.True(tc.Expected.Equal(dt), "%s: expected %v, actual %v", tc.Value, tc.Expected, dt)
+		}
+		d, err := DateParsePattern(tc.Pattern, tc.Value)
+		if assert.NoError(err, tc.Value) {
+			year, month, day := tc.Expected.Date()
+			assert.True(DateFor(year, month, day).Equal(d), "%s: expected %v, actual %v", tc.Value, tc.Expected, d)
+		}
+	}
+}
+
+func TestParsePatternError(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Pattern string
+		Value   string
+	}{
+		{"yyyy-MM-dd", "2025-02-29"},
+		{"yyyy-MM-dd", "2025-09-30x"},
+		{"yyyy-MM-dd", "2025/09/30"},
+		{"yyyyMMdd", "2025930"},
+		{"EEE d MMM yyyy", "Mon 30 Sep 2025"},
+		{"QQQ yyyy MMM", "Q3 2025 Oct"},
+		{"MM-dd", "09-30"},
+		{"YYYY-'W'ww", "2025-W53"},
+		{"yyyy-MM-dd HH:mm", "2025-09-30 24:00"},
+		{"yyyy-MM-dd zzz", "2025-09-30 UTC"},
+	}
+
+	for _, tc := range testCases {
+		_, err := DateTimeParsePattern(tc.Pattern, tc.Value)
+		assert.Error(err, tc.Value)
+	}
+}
+
+func TestLocaleParsePattern(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Tag      string
+		Pattern  string
+		Value    string
+		Expected Date
+	}{
+		{"fr", "EEEE d MMMM y", "mardi 30 septembre 2025", DateFor(2025, 9, 30)},
+		{"fr", "QQQQ y", "3e trimestre 2025", DateFor(2025, 7, 1)},
+		{"de", "EEEE, 'den' o MMMM y", "Dienstag, den 2. September 2025", DateFor(2025, 9, 2)},
+		{"ja", "y年M月d日(E)", "2025年9月2日(火)", DateFor(2025, 9, 2)},
+		{"ar", "d MMMM y", "٢ سبتمبر ٢٠٢٥", DateFor(2025, 9, 2)},
+	}
+
+	for _, tc := range testCases {
+		l := mustLocale(tc.Tag)
+		d, err := l.ParseDatePattern(tc.Pattern, tc.Value)
+		if assert.NoError(err, tc.Value) {
+			assert.True(tc.Expected.Equal(d), "%s: expected %v, actual %v", tc.Value, tc.Expected, d)
+		}
+		assert.Equal(tc.Value, l.FormatDatePattern(tc.Expected, tc.Pattern))
+	}
+}
@@ -0,0 +1,107 @@
+package local
+
+import (
+	"time"
+)
+
+// persianCalendar implements the Solar Hijri calendar, using the algorithm
+// by Kazimierz M. Borkowski, "The Persian calendar for 3000 years".
+type persianCalendar struct{}
+
+// persianBreaks are the years in which the pattern of leap years
+// in the Persian calendar changes.
+var persianBreaks = []int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+func (persianCalendar) Name() string {
+	return "persian"
+}
+
+func (c persianCalendar) FromDate(d Date) (CalendarDate, error) {
+	n := rataDie(d)
+	year := d.Year() - 621
+	first, leap, err := c.firstDay(year)
+	if err != nil {
+		return CalendarDate{}, err
+	}
+	k := n - first
+	if k >= 0 && k <= 185 {
+		return CalendarDate{Year: year, Month: 1 + k/31, Day: k%31 + 1}, nil
+	}
+	if k >= 0 {
+		k -= 186
+	} else {
+		year--
+		if _, _, err := c.firstDay(year); err != nil {
+			return CalendarDate{}, err
+		}
+		k += 179
+		if leap == 1 {
+			// the previous year was a leap year
+			k++
+		}
+	}
+	return CalendarDate{Year: year, Month: 7 + k/30, Day: k%30 + 1}, nil
+}
+
+func (c persianCalendar) ToDate(cd CalendarDate) (Date, error) {
+	first, _, err := c.firstDay(cd.Year)
+	if err != nil {
+		return Date{}, err
+	}
+	if cd.Month < 1 || cd.Month > 12 || cd.Day < 1 || cd.Day > c.daysInMonth(cd.Year, cd.Month) {
+		return Date{}, errInvalidCalendarDate
+	}
+	n := first + (cd.Month-1)*31 - cd.Month/7*(cd.Month-7) + cd.Day - 1
+	return dateFromRataDie(n), nil
+}
+
+func (c persianCalendar) daysInMonth(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	}
+	if _, leap, err := c.firstDay(year); err == nil && leap == 0 {
+		return 30
+	}
+	return 29
+}
+
+// firstDay returns the rata die of 1 Farvardin of year, and the number
+// of years since the last leap year, so that leap is zero in a leap year.
+func (persianCalendar) firstDay(year int) (n int, leap int, err error) {
+	if year < persianBreaks[0] || year >= persianBreaks[len(persianBreaks)-1] {
+		return 0, 0, errOutOfCalendarRange
+	}
+	gregorianYear := year + 621
+	leapJ := -14
+	jp := persianBreaks[0]
+	jump := 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	years := year - jp
+	leapJ += years/33*8 + (years%33+3)/4
+	if jump%33 == 4 && jump-years == 4 {
+		leapJ++
+	}
+	leapG := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+	march := 20 + leapJ - leapG
+	if jump-years < 6 {
+		years = years - jump + (jump+4)/33*33
+	}
+	leap = ((years+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return rataDie(DateFor(gregorianYear, time.March, march)), leap, nil
+}
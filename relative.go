@@ -0,0 +1,430 @@
+package local
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var errNoRelativeDate = errors.New("no relative date expression found")
+
+// Span identifies the part of a string matched by a relative date
+// expression. Start and End are byte offsets, so the matched text
+// is s[Start:End].
+type Span struct {
+	Start int
+	End   int
+}
+
+// RelativeGrammar finds relative date expressions, such as "tomorrow" or
+// "in 3 weeks", in text written in a particular language. EnglishGrammar is
+// built in: implement RelativeGrammar to support other languages.
+type RelativeGrammar interface {
+	// FindRelative returns the first relative date expression in s,
+	// resolved relative to ref, and the span of s that it occupies.
+	// If s does not contain a relative date expression, ok is false.
+	FindRelative(s string, ref DateTime) (dt DateTime, span Span, ok bool)
+}
+
+// EnglishGrammar recognizes relative date expressions written in English,
+// including:
+//
+//	today, tomorrow, yesterday, now
+//	the day after tomorrow, the day before yesterday
+//	Friday, this Friday, next Friday, last Friday
+//	this week, next month, last year
+//	in 3 weeks, in a month, 2 days ago, 10 days from now
+//	first day of next month, last day of February, last day of this year
+//
+// Numbers may be written in digits or as words from "one" to "twelve".
+// The units day, week, fortnight, month and year are recognized, as well as
+// hour, minute and second, which are useful when the reference is a DateTime.
+// Adding months or years to a date that does not exist in the resulting month
+// gives the last day of that month, so one month after January 31 is the last
+// day of February.
+//
+// A weekday on its own, or preceded by "this", refers to the next occurrence
+// on or after the reference date. "next" and "last" refer to the next occurrence
+// after, and the last occurrence before, the reference date.
+var EnglishGrammar RelativeGrammar = englishGrammar{}
+
+// ParseRelativeDate finds the first relative date expression in s and resolves
+// it relative to ref. It returns the resolved date, along with the span of s that
+// contains the expression. If no grammars are specified, EnglishGrammar is used.
+// If more than one grammar is specified, the expression that starts first in s
+// is used.
+func ParseRelativeDate(s string, ref Date, grammars ...RelativeGrammar) (Date, Span, error) {
+	year, month, day := ref.Date()
+	dt, span, err := ParseRelativeDateTime(s, DateTimeFor(year, month, day, 0, 0, 0), grammars...)
+	if err != nil {
+		return Date{}, Span{}, err
+	}
+	year, month, day = dt.Date()
+	return DateFor(year, month, day), span, nil
+}
+
+// ParseRelativeDateTime finds the first relative date expression in s and resolves
+// it relative to ref. The time of day of ref is kept unless the expression
+// specifies hours, minutes or seconds. See ParseRelativeDate for details.
+func ParseRelativeDateTime(s string, ref DateTime, grammars ...RelativeGrammar) (DateTime, Span, error) {
+	if len(grammars) == 0 {
+		grammars = []RelativeGrammar{EnglishGrammar}
+	}
+	var found bool
+	var result DateTime
+	var resultSpan Span
+	for _, g := range grammars {
+		dt, span, ok := g.FindRelative(s, ref)
+		if ok && (!found || span.Start < resultSpan.Start) {
+			found, result, resultSpan = true, dt, span
+		}
+	}
+	if !found {
+		return DateTime{}, Span{}, errNoRelativeDate
+	}
+	return result, resultSpan, nil
+}
+
+// relativeWord is a word in text being searched for relative date expressions.
+type relativeWord struct {
+	text  string // lower case
+	start int
+	end   int
+}
+
+// splitWords splits s into words made up of letters and digits.
+func splitWords(s string) []relativeWord {
+	var words []relativeWord
+	start := -1
+	for i, r := range s {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWordRune && start < 0 {
+			start = i
+		} else if !isWordRune && start >= 0 {
+			words = append(words, relativeWord{text: strings.ToLower(s[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, relativeWord{text: strings.ToLower(s[start:]), start: start, end: len(s)})
+	}
+	return words
+}
+
+type englishGrammar struct{}
+
+// englishRule attempts to match a relative date expression at the start of
+// words. It returns the resolved date-time and the number of words matched.
+type englishRule func(words []relativeWord, ref DateTime) (DateTime, int, bool)
+
+var englishRules = []englishRule{
+	englishDayWord,
+	englishWeekday,
+	englishPeriod,
+	englishOffset,
+	englishFirstLastDay,
+}
+
+// FindRelative implements the RelativeGrammar interface.
+func (englishGrammar) FindRelative(s string, ref DateTime) (DateTime, Span, bool) {
+	words := splitWords(s)
+	for i := range words {
+		start := i
+		if words[i].text == "the" && i+1 < len(words) {
+			start = i + 1
+		}
+		var result DateTime
+		var matched int
+		for _, rule := range englishRules {
+			if dt, n, ok := rule(words[start:], ref); ok && n > matched {
+				result, matched = dt, n
+			}
+		}
+		if matched > 0 {
+			span := Span{Start: words[i].start, End: words[start+matched-1].end}
+			return result, span, true
+		}
+	}
+	return DateTime{}, Span{}, false
+}
+
+// wordsEqual reports whether words starts with text.
+func wordsEqual(words []relativeWord, text ...string) bool {
+	if len(words) < len(text) {
+		return false
+	}
+	for i := range text {
+		if words[i].text != text[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// englishDayWord matches today, tomorrow, yesterday and now.
+func englishDayWord(words []relativeWord, ref DateTime) (DateTime, int, bool) {
+	switch {
+	case wordsEqual(words, "day", "after", "tomorrow"):
+		return ref.AddDate(0, 0, 2), 3, true
+	case wordsEqual(words, "day", "before", "yesterday"):
+		return ref.AddDate(0, 0, -2), 3, true
+	case wordsEqual(words, "today"), wordsEqual(words, "now"):
+		return ref, 1, true
+	case wordsEqual(words, "tomorrow"):
+		return ref.AddDate(0, 0, 1), 1, true
+	case wordsEqual(words, "yesterday"):
+		return ref.AddDate(0, 0, -1), 1, true
+	}
+	return DateTime{}, 0, false
+}
+
+// englishWeekday matches a weekday name, optionally preceded by
+// this, next or last.
+func englishWeekday(words []relativeWord, ref DateTime) (DateTime, int, bool) {
+	if len(words) == 0 {
+		return DateTime{}, 0, false
+	}
+	direction := 0
+	n := 0
+	switch words[0].text {
+	case "this":
+		n = 1
+	case "next", "coming":
+		direction, n = 1, 1
+	case "last", "previous":
+		direction, n = -1, 1
+	}
+	if len(words) <= n {
+		return DateTime{}, 0, false
+	}
+	weekday, ok := englishWeekdays[words[n].text]
+	if !ok {
+		return DateTime{}, 0, false
+	}
+	days := (int(weekday-ref.Weekday()) + 7) % 7
+	switch {
+	case direction > 0 && days == 0:
+		days = 7
+	case direction < 0:
+		days -= 7
+	}
+	return ref.AddDate(0, 0, days), n + 1, true
+}
+
+// englishPeriod matches this, next or last followed by week, fortnight,
+// month or year.
+func englishPeriod(words []relativeWord, ref DateTime) (DateTime, int, bool) {
+	if len(words) < 2 {
+		return DateTime{}, 0, false
+	}
+	var n int
+	switch words[0].text {
+	case "this":
+		n = 0
+	case "next":
+		n = 1
+	case "last", "previous":
+		n = -1
+	default:
+		return DateTime{}, 0, false
+	}
+	unit, ok := englishUnits[words[1].text]
+	if !ok || unit == "hour" || unit == "minute" || unit == "second" || unit == "day" {
+		return DateTime{}, 0, false
+	}
+	return addRelativeUnits(ref, n, unit), 2, true
+}
+
+// englishOffset matches "in 3 weeks", "3 weeks ago" and "3 weeks from now".
+func englishOffset(words []relativeWord, ref DateTime) (DateTime, int, bool) {
+	if wordsEqual(words, "in") {
+		count, unit, ok := englishQuantity(words[1:])
+		if !ok {
+			return DateTime{}, 0, false
+		}
+		return addRelativeUnits(ref, count, unit), 3, true
+	}
+	count, unit, ok := englishQuantity(words)
+	if !ok {
+		return DateTime{}, 0, false
+	}
+	switch {
+	case wordsEqual(words[2:], "ago"):
+		return addRelativeUnits(ref, -count, unit), 3, true
+	case wordsEqual(words[2:], "from", "now"):
+		return addRelativeUnits(ref, count, unit), 4, true
+	case wordsEqual(words[2:], "later"), wordsEqual(words[2:], "hence"):
+		return addRelativeUnits(ref, count, unit), 3, true
+	}
+	return DateTime{}, 0, false
+}
+
+// englishQuantity matches a number followed by a unit, such as "3 weeks" or "a day".
+func englishQuantity(words []relativeWord) (count int, unit string, ok bool) {
+	if len(words) < 2 {
+		return 0, "", false
+	}
+	count, ok = englishNumbers[words[0].text]
+	if !ok {
+		n, err := strconv.Atoi(words[0].text)
+		if err != nil {
+			return 0, "", false
+		}
+		count = n
+	}
+	unit, ok = englishUnits[words[1].text]
+	return count, unit, ok
+}
+
+// englishFirstLastDay matches "first day of" or "last day of" followed by
+// a month name or "this", "next" or "last" and then month or year.
+func englishFirstLastDay(words []relativeWord, ref DateTime) (DateTime, int, bool) {
+	var last bool
+	switch {
+	case wordsEqual(words, "first", "day", "of"):
+	case wordsEqual(words, "last", "day", "of"):
+		last = true
+	default:
+		return DateTime{}, 0, false
+	}
+	words = words[3:]
+	n := 3
+	if wordsEqual(words, "the") {
+		words = words[1:]
+		n++
+	}
+
+	year, month, _ := ref.Date()
+	hour, minute, second := ref.Clock()
+	unit := "month"
+	if len(words) > 0 {
+		if m, ok := englishMonths[words[0].text]; ok {
+			month = m
+			n++
+			if len(words) > 1 {
+				if y, err := strconv.Atoi(words[1].text); err == nil && len(words[1].text) == 4 {
+					year = y
+					n++
+				}
+			}
+			return firstOrLastDay(year, month, "month", last, hour, minute, second, ref.Nanosecond()), n, true
+		}
+	}
+	if len(words) < 2 {
+		return DateTime{}, 0, false
+	}
+	var count int
+	switch words[0].text {
+	case "this":
+	case "next":
+		count = 1
+	case "last", "previous":
+		count = -1
+	default:
+		return DateTime{}, 0, false
+	}
+	switch englishUnits[words[1].text] {
+	case "month":
+	case "year":
+		unit = "year"
+	default:
+		return DateTime{}, 0, false
+	}
+	year, month, _ = addRelativeUnits(ref, count, unit).Date()
+	return firstOrLastDay(year, month, unit, last, hour, minute, second, ref.Nanosecond()), n + 2, true
+}
+
+// firstOrLastDay returns the first or last day of the month or year.
+func firstOrLastDay(year int, month time.Month, unit string, last bool, hour, minute, second, nanosecond int) DateTime {
+	day := 1
+	if unit == "year" {
+		month = time.January
+		if last {
+			month, day = time.December, 31
+		}
+	} else if last {
+		day = daysIn(month, year)
+	}
+	return DateTimeForNano(year, month, day, hour, minute, second, nanosecond)
+}
+
+// addRelativeUnits adds count units to dt. Adding months or years gives the last
+// day of the resulting month if the day of the month would otherwise overflow.
+func addRelativeUnits(dt DateTime, count int, unit string) DateTime {
+	switch unit {
+	case "day":
+		return dt.AddDate(0, 0, count)
+	case "week":
+		return dt.AddDate(0, 0, 7*count)
+	case "fortnight":
+		return dt.AddDate(0, 0, 14*count)
+	case "month":
+		return addMonthsClamped(dt, count)
+	case "year":
+		return addMonthsClamped(dt, 12*count)
+	case "hour":
+		return dt.Add(time.Duration(count) * time.Hour)
+	case "minute":
+		return dt.Add(time.Duration(count) * time.Minute)
+	case "second":
+		return dt.Add(time.Duration(count) * time.Second)
+	}
+	return dt
+}
+
+// addMonthsClamped adds months to dt. If the day of the month does not
+// exist in the resulting month, the last day of the month is used.
+func addMonthsClamped(dt DateTime, months int) DateTime {
+	year, month, day := dt.Date()
+	hour, minute, second := dt.Clock()
+	first := DateFor(year, month+time.Month(months), 1)
+	year, month = first.Year(), first.Month()
+	if n := daysIn(month, year); day > n {
+		day = n
+	}
+	return DateTimeForNano(year, month, day, hour, minute, second, dt.Nanosecond())
+}
+
+var englishNumbers = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4,
+	"five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9,
+	"ten": 10, "eleven": 11, "twelve": 12,
+}
+
+var englishUnits = map[string]string{
+	"day": "day", "days": "day",
+	"week": "week", "weeks": "week",
+	"fortnight": "fortnight", "fortnights": "fortnight",
+	"month": "month", "months": "month",
+	"year": "year", "years": "year",
+	"hour": "hour", "hours": "hour",
+	"minute": "minute", "minutes": "minute",
+	"second": "second", "seconds": "second",
+}
+
+var englishWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var englishMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
@@ -0,0 +1,118 @@
+package local
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRelativeDate(t *testing.T) {
+	assert := assert.New(t)
+
+	// Tuesday 30 September 2025
+	ref := DateFor(2025, 9, 30)
+	testCases := []struct {
+		Text     string
+		Expected Date
+		Matched  string
+		Error    bool
+	}{
+		{Text: "today", Expected: ref, Matched: "today"},
+		{Text: "Tomorrow", Expected: DateFor(2025, 10, 1), Matched: "Tomorrow"},
+		{Text: "remind me yesterday please", Expected: DateFor(2025, 9, 29), Matched: "yesterday"},
+		{Text: "the day after tomorrow", Expected: DateFor(2025, 10, 2), Matched: "the day after tomorrow"},
+		{Text: "day before yesterday", Expected: DateFor(2025, 9, 28), Matched: "day before yesterday"},
+		{Text: "next Friday", Expected: DateFor(2025, 10, 3), Matched: "next Friday"},
+		{Text: "on Friday", Expected: DateFor(2025, 10, 3), Matched: "Friday"},
+		{Text: "this Tuesday", Expected: DateFor(2025, 9, 30), Matched: "this Tuesday"},
+		{Text: "next Tuesday", Expected: DateFor(2025, 10, 7), Matched: "next Tuesday"},
+		{Text: "last Tuesday", Expected: DateFor(2025, 9, 23), Matched: "last Tuesday"},
+		{Text: "last Wednesday", Expected: DateFor(2025, 9, 24), Matched: "last Wednesday"},
+		{Text: "last mon", Expected: DateFor(2025, 9, 29), Matched: "last mon"},
+		{Text: "next sat", Expected: DateFor(2025, 10, 4), Matched: "next sat"},
+		{Text: "last sun", Expected: DateFor(2025, 9, 28), Matched: "last sun"},
+		{Text: "next week", Expected: DateFor(2025, 10, 7), Matched: "next week"},
+		{Text: "last month", Expected: DateFor(2025, 8, 30), Matched: "last month"},
+		{Text: "next year", Expected: DateFor(2026, 9, 30), Matched: "next year"},
+		{Text: "in 3 weeks", Expected: DateFor(2025, 10, 21), Matched: "in 3 weeks"},
+		{Text: "due in a fortnight.", Expected: DateFor(2025, 10, 14), Matched: "in a fortnight"},
+		{Text: "2 days ago", Expected: DateFor(2025, 9, 28), Matched: "2 days ago"},
+		{Text: "ten days from now", Expected: DateFor(2025, 10, 10), Matched: "ten days from now"},
+		{Text: "in 5 months", Expected: DateFor(2026, 2, 28), Matched: "in 5 months"},
+		{Text: "last day of next month", Expected: DateFor(2025, 10, 31), Matched: "last day of next month"},
+		{Text: "the first day of next month", Expected: DateFor(2025, 10, 1), Matched: "the first day of next month"},
+		{Text: "last day of February", Expected: DateFor(2025, 2, 28), Matched: "last day of February"},
+		{Text: "last day of feb 2028", Expected: DateFor(2028, 2, 29), Matched: "last day of feb 2028"},
+		{Text: "first day of next year", Expected: DateFor(2026, 1, 1), Matched: "first day of next year"},
+		{Text: "last day of the last year", Expected: DateFor(2024, 12, 31), Matched: "last day of the last year"},
+		{Text: "in 36 hours", Expected: DateFor(2025, 10, 1), Matched: "in 36 hours"},
+		{Text: "sometime soon", Error: true},
+		{Text: "in weeks", Error: true},
+		{Text: "", Error: true},
+	}
+
+	for _, tc := range testCases {
+		d, span, err := ParseRelativeDate(tc.Text, ref)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+			continue
+		}
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Expected, d, tc.Text+": "+datesNotEqual(tc.Expected, d))
+		assert.Equal(tc.Matched, tc.Text[span.Start:span.End], tc.Text)
+	}
+}
+
+func TestParseRelativeDateTime(t *testing.T) {
+	assert := assert.New(t)
+	ref := DateTimeFor(2025, 1, 31, 9, 30, 0)
+	testCases := []struct {
+		Text     string
+		Expected DateTime
+	}{
+		{Text: "now", Expected: ref},
+		{Text: "tomorrow", Expected: DateTimeFor(2025, 2, 1, 9, 30, 0)},
+		{Text: "in 2 hours", Expected: DateTimeFor(2025, 1, 31, 11, 30, 0)},
+		{Text: "45 minutes ago", Expected: DateTimeFor(2025, 1, 31, 8, 45, 0)},
+		{Text: "in a month", Expected: DateTimeFor(2025, 2, 28, 9, 30, 0)},
+		{Text: "last day of next month", Expected: DateTimeFor(2025, 2, 28, 9, 30, 0)},
+	}
+
+	for _, tc := range testCases {
+		dt, _, err := ParseRelativeDateTime(tc.Text, ref)
+		assert.NoError(err, tc.Text)
+		assert.Equal(tc.Expected, dt, tc.Text+": "+dateTimesNotEqual(tc.Expected, dt))
+	}
+}
+
+// frenchTestGrammar is a minimal grammar used to test extending the relative parser.
+type frenchTestGrammar struct{}
+
+func (frenchTestGrammar) FindRelative(s string, ref DateTime) (DateTime, Span, bool) {
+	if i := strings.Index(s, "demain"); i >= 0 {
+		return ref.AddDate(0, 0, 1), Span{Start: i, End: i + len("demain")}, true
+	}
+	return DateTime{}, Span{}, false
+}
+
+func TestParseRelativeDateGrammars(t *testing.T) {
+	assert := assert.New(t)
+	ref := DateFor(2025, 9, 30)
+
+	d, span, err := ParseRelativeDate("à demain", ref, frenchTestGrammar{})
+	assert.NoError(err)
+	assert.Equal(DateFor(2025, 10, 1), d)
+	assert.Equal(Span{Start: 3, End: 9}, span)
+
+	_, _, err = ParseRelativeDate("tomorrow", ref, frenchTestGrammar{})
+	assert.Error(err)
+
+	// the expression that occurs first is used
+	d, _, err = ParseRelativeDate("yesterday, not demain", ref, frenchTestGrammar{}, EnglishGrammar)
+	assert.NoError(err)
+	assert.Equal(DateFor(2025, 9, 29), d)
+	d, _, err = ParseRelativeDate("demain, not yesterday", ref, frenchTestGrammar{}, EnglishGrammar)
+	assert.NoError(err)
+	assert.Equal(DateFor(2025, 10, 1), d)
+}
@@ -0,0 +1,116 @@
+package local
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	errInvalidRFC3339Date     = errors.New("invalid RFC 3339 full-date")
+	errInvalidRFC3339DateTime = errors.New("invalid RFC 3339 date-time")
+)
+
+// StrictJSON controls how Date and DateTime values are decoded from JSON.
+//
+// When StrictJSON is false (the default), UnmarshalJSON accepts any
+// of the formats recognized by DateParse and DateTimeParse.
+//
+// When StrictJSON is true, Date.UnmarshalJSON only accepts a quoted
+// RFC 3339 full-date (yyyy-mm-dd) and DateTime.UnmarshalJSON only accepts
+// a quoted RFC 3339 full-date and partial-time (yyyy-mm-ddThh:mm:ss).
+// This corresponds to the "date" format in OpenAPI, and is useful when
+// enforcing an API contract.
+//
+// StrictJSON is intended to be set once during program initialization.
+var StrictJSON bool
+
+var rfc3339Regexp = struct {
+	fullDate *regexp.Regexp
+	dateTime *regexp.Regexp
+}{
+	fullDate: regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`),
+	dateTime: regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})[Tt](\d{2}):(\d{2}):(\d{2})(\.\d+)?$`),
+}
+
+// DateParseRFC3339 parses an RFC 3339 full-date (yyyy-mm-dd) and returns
+// the date value it represents. Unlike DateParse, no other formats are
+// accepted, leading and trailing space is not permitted, and month and
+// day values outside their usual ranges are rejected rather than normalized.
+func DateParseRFC3339(s string) (Date, error) {
+	match := rfc3339Regexp.fullDate.FindStringSubmatch(s)
+	if match == nil {
+		return Date{}, errInvalidRFC3339Date
+	}
+
+	// no error checking here because matching the regexp
+	// guarantees that parsing the strings will succeed.
+	year, _ := strconv.Atoi(match[1])
+	month, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	if !isValidDate(year, month, day) {
+		return Date{}, errInvalidRFC3339Date
+	}
+
+	return DateFor(year, time.Month(month), day), nil
+}
+
+// DateTimeParseRFC3339 parses an RFC 3339 full-date and partial-time
+// (yyyy-mm-ddThh:mm:ss, with optional fractional seconds) and returns the
+// date-time value it represents. Time offsets ("Z", "+10:00") are not
+// permitted, because a local date-time does not refer to a timezone.
+// Leap seconds cannot be represented by a DateTime and are rejected.
+// Fractional seconds are kept to DateTimePrecision.
+func DateTimeParseRFC3339(s string) (DateTime, error) {
+	match := rfc3339Regexp.dateTime.FindStringSubmatch(s)
+	if match == nil {
+		return DateTime{}, errInvalidRFC3339DateTime
+	}
+
+	// no error checking here because matching the regexp
+	// guarantees that parsing the strings will succeed.
+	year, _ := strconv.Atoi(match[1])
+	month, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	hour, _ := strconv.Atoi(match[4])
+	minute, _ := strconv.Atoi(match[5])
+	second, _ := strconv.Atoi(match[6])
+	if !isValidDate(year, month, day) || !isValidClock(hour, minute, second) {
+		return DateTime{}, errInvalidRFC3339DateTime
+	}
+
+	dt := DateTimeForNano(year, time.Month(month), day, hour, minute, second, parseFraction(match[7]))
+	return dt.Truncate(DateTimePrecision), nil
+}
+
+// unquoteJSON returns the contents of a JSON string that does not contain
+// any escape sequences. None of the RFC 3339 formats require escaping.
+func unquoteJSON(data []byte) (string, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+	return string(data[1 : len(data)-1]), true
+}
+
+// isValidDate reports whether year, month and day specify a date
+// without requiring normalization.
+func isValidDate(year, month, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	return day <= daysIn(time.Month(month), year)
+}
+
+// isValidClock reports whether hour, minute and second specify a
+// time of day without requiring normalization.
+func isValidClock(hour, minute, second int) bool {
+	return hour >= 0 && hour < 24 &&
+		minute >= 0 && minute < 60 &&
+		second >= 0 && second < 60
+}
+
+// daysIn returns the number of days in the month of the year.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
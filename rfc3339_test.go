@@ -0,0 +1,116 @@
+package local
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateParseRFC3339(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Expected Date
+		Error    bool
+	}{
+		{Text: "2095-09-30", Expected: DateFor(2095, 9, 30)},
+		{Text: "2096-02-29", Expected: DateFor(2096, 2, 29)},
+		{Text: "0000-01-01", Expected: DateFor(0, 1, 1)},
+		{Text: "2095-02-29", Error: true},
+		{Text: "2095-13-01", Error: true},
+		{Text: "2095-00-01", Error: true},
+		{Text: "2095-09-31", Error: true},
+		{Text: "2095-9-30", Error: true},
+		{Text: "2095/09/30", Error: true},
+		{Text: "2095.09.30", Error: true},
+		{Text: "20950930", Error: true},
+		{Text: "2095-273", Error: true},
+		{Text: "-2095-09-30", Error: true},
+		{Text: " 2095-09-30", Error: true},
+		{Text: "2095-09-30T00:00:00Z", Error: true},
+	}
+
+	for _, tc := range testCases {
+		d, err := DateParseRFC3339(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+		} else {
+			assert.NoError(err, tc.Text)
+			assert.Equal(tc.Expected, d, datesNotEqual(tc.Expected, d))
+		}
+	}
+}
+
+func TestDateTimeParseRFC3339(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Expected DateTime
+		Error    bool
+	}{
+		{Text: "2095-09-30T11:47:03", Expected: DateTimeFor(2095, 9, 30, 11, 47, 3)},
+		{Text: "2095-09-30t11:47:03", Expected: DateTimeFor(2095, 9, 30, 11, 47, 3)},
+		{Text: "2095-09-30T23:59:59.999", Expected: DateTimeFor(2095, 9, 30, 23, 59, 59)},
+		{Text: "2095-09-30T24:00:00", Error: true},
+		{Text: "2095-09-30T11:60:00", Error: true},
+		{Text: "2095-09-30T11:47:60", Error: true},
+		{Text: "2095-09-31T11:47:03", Error: true},
+		{Text: "2095-09-30T11:47", Error: true},
+		{Text: "2095-09-30 11:47:03", Error: true},
+		{Text: "2095-09-30T11:47:03Z", Error: true},
+		{Text: "2095-09-30T11:47:03+10:00", Error: true},
+		{Text: "2095-09-30T11:47:03.", Error: true},
+		{Text: "2095-09-30", Error: true},
+		{Text: "20950930T114703", Error: true},
+	}
+
+	for _, tc := range testCases {
+		dt, err := DateTimeParseRFC3339(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+		} else {
+			assert.NoError(err, tc.Text)
+			assert.Equal(tc.Expected, dt, dateTimesNotEqual(tc.Expected, dt))
+		}
+	}
+}
+
+func TestStrictJSON(t *testing.T) {
+	assert := assert.New(t)
+	defer func(strict bool) { StrictJSON = strict }(StrictJSON)
+
+	type testStruct struct {
+		Date     Date
+		DateTime DateTime
+	}
+
+	testCases := []struct {
+		Text   string
+		Strict bool
+		Error  bool
+	}{
+		{Text: `{"Date":"2095-09-30","DateTime":"2095-09-30T11:47:03"}`, Strict: true},
+		{Text: `{"Date":"2095-09-30","DateTime":"2095-09-30T11:47:03"}`, Strict: false},
+		{Text: `{"Date":"2095/09/30","DateTime":"2095-09-30T11:47:03"}`, Strict: true, Error: true},
+		{Text: `{"Date":"2095/09/30","DateTime":"2095-09-30T11:47:03"}`, Strict: false},
+		{Text: `{"Date":"2095-09-30","DateTime":"2095-09-30T11:47:03+10:00"}`, Strict: true, Error: true},
+		{Text: `{"Date":"2095-09-30","DateTime":"2095-09-30 11:47"}`, Strict: true, Error: true},
+		{Text: `{"Date":"2095-09-30","DateTime":"2095-09-30 11:47"}`, Strict: false},
+		{Text: `{"Date":20950930,"DateTime":"2095-09-30T11:47:03"}`, Strict: true, Error: true},
+	}
+
+	for _, tc := range testCases {
+		StrictJSON = tc.Strict
+		var st testStruct
+		err := json.Unmarshal([]byte(tc.Text), &st)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+		} else {
+			assert.NoError(err, tc.Text)
+			assert.Equal(DateFor(2095, 9, 30), st.Date, tc.Text)
+			year, month, day := st.DateTime.Date()
+			assert.Equal(DateFor(2095, 9, 30), DateFor(year, month, day), tc.Text)
+		}
+	}
+}
@@ -0,0 +1,460 @@
+package local
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Strftime returns a textual representation of d formatted according to
+// format, which uses the conversion specifications of the C strftime function.
+// The following specifications are supported:
+//
+//	%a  abbreviated weekday name (Mon)
+//	%A  full weekday name (Monday)
+//	%b  abbreviated month name (Jan), also %h
+//	%B  full month name (January)
+//	%C  century (20)
+//	%d  day of the month (01-31)
+//	%D  equivalent to %m/%d/%y
+//	%e  day of the month, space padded ( 1-31)
+//	%f  microseconds (000000-999999)
+//	%F  equivalent to %Y-%m-%d
+//	%g  ISO 8601 week-based year without century (00-99)
+//	%G  ISO 8601 week-based year
+//	%H  hour (00-23)
+//	%I  hour (01-12)
+//	%j  day of the year (001-366)
+//	%k  hour, space padded ( 0-23)
+//	%l  hour, space padded ( 1-12)
+//	%m  month (01-12)
+//	%M  minute (00-59)
+//	%n  newline
+//	%p  AM or PM
+//	%R  equivalent to %H:%M
+//	%S  second (00-59)
+//	%t  tab
+//	%T  equivalent to %H:%M:%S
+//	%u  day of the week, where Monday is 1 (1-7)
+//	%U  week of the year, where weeks start on Sunday (00-53)
+//	%V  ISO 8601 week number (01-53)
+//	%w  day of the week, where Sunday is 0 (0-6)
+//	%W  week of the year, where weeks start on Monday (00-53)
+//	%y  year without century (00-99)
+//	%Y  year
+//	%%  a literal %
+//
+// Names are in English. Time specifications are formatted as midnight.
+// Unsupported specifications are copied to the output unchanged.
+func (d Date) Strftime(format string) string {
+	year, month, day := d.Date()
+	return strftime(format, DateTimeFor(year, month, day, 0, 0, 0))
+}
+
+// Strftime returns a textual representation of dt formatted according to
+// format, which uses the conversion specifications of the C strftime function.
+// See Date.Strftime for the supported specifications.
+func (dt DateTime) Strftime(format string) string {
+	return strftime(format, dt)
+}
+
+// DateStrptime parses value according to format, which uses the conversion
+// specifications described for Date.Strftime, and returns the date it
+// represents. Any time fields are parsed and discarded.
+//
+// The date is determined from the first of the following combinations present
+// in format: year, month and day (%Y %m %d); year and day of the year (%Y %j);
+// ISO 8601 week-based year, week and weekday (%G %V %u); year, week of the year
+// and weekday (%Y %U %w or %Y %W %u). The weekday may also be given by name
+// (%a or %A), and a weekday in the value must match the date. A year without
+// century (%y) is in the range 1969 to 2068. A year (%Y or %G) has at most
+// four digits, and may have a leading sign. White space in the format matches
+// zero or more white space characters in the value. Names are matched in
+// English, ignoring case.
+func DateStrptime(format, value string) (Date, error) {
+	dt, err := DateTimeStrptime(format, value)
+	if err != nil {
+		return Date{}, err
+	}
+	year, month, day := dt.Date()
+	return DateFor(year, month, day), nil
+}
+
+// DateTimeStrptime parses value according to format, which uses the conversion
+// specifications described for Date.Strftime, and returns the date-time it
+// represents. See DateStrptime for details of how the date is determined.
+// Time fields that are not present in format are zero.
+func DateTimeStrptime(format, value string) (DateTime, error) {
+	var p strptimeParser
+	if err := p.parse(format, value); err != nil {
+		return DateTime{}, fmt.Errorf("cannot parse %q as %q: %v", value, format, err)
+	}
+	dt, err := p.dateTime()
+	if err != nil {
+		return DateTime{}, fmt.Errorf("cannot parse %q as %q: %v", value, format, err)
+	}
+	return dt, nil
+}
+
+// strftimeExpansions contains the specifications that are
+// equivalent to a sequence of other specifications.
+var strftimeExpansions = map[byte]string{
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+}
+
+func strftime(format string, dt DateTime) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		b.WriteString(strftimeField(format[i], dt))
+	}
+	return b.String()
+}
+
+func strftimeField(c byte, dt DateTime) string {
+	year, month, day, hour, minute, second := dt.DateTime()
+	weekday := dt.Weekday()
+	switch c {
+	case 'a':
+		return localeEnglish.shortDays[weekday]
+	case 'A':
+		return localeEnglish.days[weekday]
+	case 'b', 'h':
+		return localeEnglish.shortMonths[month-1]
+	case 'B':
+		return localeEnglish.months[month-1]
+	case 'C':
+		return fmt.Sprintf("%02d", floorDiv(year, 100))
+	case 'd':
+		return fmt.Sprintf("%02d", day)
+	case 'e':
+		return fmt.Sprintf("%2d", day)
+	case 'f':
+		return fmt.Sprintf("%06d", dt.Nanosecond()/1000)
+	case 'g':
+		isoYear, _ := dt.ISOWeek()
+		return fmt.Sprintf("%02d", floorMod(isoYear, 100))
+	case 'G':
+		isoYear, _ := dt.ISOWeek()
+		return formatYear(isoYear)
+	case 'H':
+		return fmt.Sprintf("%02d", hour)
+	case 'I':
+		return fmt.Sprintf("%02d", hour12(hour))
+	case 'j':
+		return fmt.Sprintf("%03d", dt.YearDay())
+	case 'k':
+		return fmt.Sprintf("%2d", hour)
+	case 'l':
+		return fmt.Sprintf("%2d", hour12(hour))
+	case 'm':
+		return fmt.Sprintf("%02d", int(month))
+	case 'M':
+		return fmt.Sprintf("%02d", minute)
+	case 'n':
+		return "\n"
+	case 'p':
+		return localeEnglish.dayPeriods[hour/12]
+	case 'S':
+		return fmt.Sprintf("%02d", second)
+	case 't':
+		return "\t"
+	case 'u':
+		return strconv.Itoa((int(weekday)+6)%7 + 1)
+	case 'U':
+		return fmt.Sprintf("%02d", (dt.YearDay()+6-int(weekday))/7)
+	case 'V':
+		_, week := dt.ISOWeek()
+		return fmt.Sprintf("%02d", week)
+	case 'w':
+		return strconv.Itoa(int(weekday))
+	case 'W':
+		return fmt.Sprintf("%02d", (dt.YearDay()+6-(int(weekday)+6)%7)/7)
+	case 'y':
+		return fmt.Sprintf("%02d", floorMod(year, 100))
+	case 'Y':
+		return formatYear(year)
+	case '%':
+		return "%"
+	}
+	if expansion, ok := strftimeExpansions[c]; ok {
+		return strftime(expansion, dt)
+	}
+	return "%" + string(c)
+}
+
+// expandStrftime replaces the specifications in format that are equivalent
+// to a sequence of other specifications.
+func expandStrftime(format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] == '%' && i+1 < len(format) {
+			i++
+			if expansion, ok := strftimeExpansions[format[i]]; ok {
+				b.WriteString(expansion)
+			} else {
+				b.WriteByte('%')
+				b.WriteByte(format[i])
+			}
+			continue
+		}
+		b.WriteByte(format[i])
+	}
+	return b.String()
+}
+
+// formatYear returns the year with at least four digits.
+func formatYear(year int) string {
+	if year < 0 {
+		return fmt.Sprintf("-%04d", -year)
+	}
+	return fmt.Sprintf("%04d", year)
+}
+
+// hour12 returns the hour on a 12-hour clock.
+func hour12(hour int) int {
+	if h := hour % 12; h != 0 {
+		return h
+	}
+	return 12
+}
+
+// strptimeParser holds the fields parsed by DateTimeStrptime.
+type strptimeParser struct {
+	fields map[byte]int
+	pm     int // 0 if not specified, 1 for AM, 2 for PM
+}
+
+func (p *strptimeParser) parse(format, value string) error {
+	p.fields = make(map[byte]int)
+	format = expandStrftime(format)
+	pos := 0
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if isSpace(c) {
+			pos = skipSpace(value, pos)
+			continue
+		}
+		if c != '%' || i+1 >= len(format) {
+			if pos >= len(value) || value[pos] != c {
+				return fmt.Errorf("expected %q", string(c))
+			}
+			pos++
+			continue
+		}
+		i++
+		n, err := p.parseField(format[i], value[pos:])
+		if err != nil {
+			return err
+		}
+		pos += n
+	}
+	if pos != len(value) {
+		return fmt.Errorf("unexpected text %q", value[pos:])
+	}
+	return nil
+}
+
+// parseField parses a single conversion specification at the start of value,
+// returning the number of bytes parsed.
+func (p *strptimeParser) parseField(c byte, value string) (int, error) {
+	switch c {
+	case 'a', 'A':
+		n, index := matchName(value, localeEnglish.days[:], localeEnglish.shortDays[:])
+		if n == 0 {
+			return 0, fmt.Errorf("expected weekday name")
+		}
+		p.fields['a'] = index
+		return n, nil
+	case 'b', 'B', 'h':
+		n, index := matchName(value, localeEnglish.months[:], localeEnglish.shortMonths[:])
+		if n == 0 {
+			return 0, fmt.Errorf("expected month name")
+		}
+		p.fields['m'] = index + 1
+		return n, nil
+	case 'p':
+		n, index := matchName(value, localeEnglish.dayPeriods[:])
+		if n == 0 {
+			return 0, fmt.Errorf("expected AM or PM")
+		}
+		p.pm = index + 1
+		return n, nil
+	case 'n', 't':
+		return skipSpace(value, 0), nil
+	case '%':
+		if !strings.HasPrefix(value, "%") {
+			return 0, fmt.Errorf("expected %%")
+		}
+		return 1, nil
+	case 'f':
+		n := countDigits(value, 0, 9)
+		if n == 0 {
+			return 0, fmt.Errorf("expected fraction")
+		}
+		p.fields['f'] = parseFraction(value[:n])
+		return n, nil
+	}
+
+	width, ok := strptimeWidths[c]
+	if !ok {
+		return 0, fmt.Errorf("unsupported specification %%%c", c)
+	}
+	start := skipSpace(value, 0)
+	if c != 'e' && c != 'k' && c != 'l' {
+		start = 0
+	}
+	end := start
+	if (c == 'Y' || c == 'G') && end < len(value) && (value[end] == '-' || value[end] == '+') {
+		end++
+	}
+	digits := countDigits(value, end, width)
+	if digits == 0 {
+		return 0, fmt.Errorf("expected number for %%%c", c)
+	}
+	end += digits
+	n, _ := strconv.Atoi(value[start:end])
+	p.fields[c] = n
+	return end, nil
+}
+
+// strptimeWidths contains the maximum number of digits for each
+// numeric conversion specification.
+var strptimeWidths = map[byte]int{
+	'C': 2, 'd': 2, 'e': 2, 'g': 2, 'G': 4, 'H': 2, 'I': 2,
+	'j': 3, 'k': 2, 'l': 2, 'm': 2, 'M': 2, 'S': 2, 'u': 1,
+	'U': 2, 'V': 2, 'w': 1, 'W': 2, 'y': 2, 'Y': 4,
+}
+
+// dateTime returns the date-time described by the parsed fields.
+func (p *strptimeParser) dateTime() (DateTime, error) {
+	f := p.fields
+	has := func(fields ...byte) bool {
+		for _, c := range fields {
+			if _, ok := f[c]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	// year
+	year, hasYear := f['Y']
+	if !hasYear && has('y') {
+		year, hasYear = f['y']+1900, true
+		if f['y'] < 69 {
+			year += 100
+		}
+		if has('C') {
+			year = f['C']*100 + f['y']
+		}
+	}
+	if !hasYear && has('C') {
+		year, hasYear = f['C']*100, true
+	}
+	isoYear, hasISOYear := f['G']
+	if !hasISOYear && has('g') {
+		isoYear, hasISOYear = 2000+f['g'], true
+		if f['g'] >= 69 {
+			isoYear -= 100
+		}
+	}
+
+	// weekday, where Monday is 0
+	weekday, hasWeekday := 0, true
+	switch {
+	case has('u'):
+		if f['u'] < 1 || f['u'] > 7 {
+			return DateTime{}, fmt.Errorf("weekday out of range")
+		}
+		weekday = f['u'] - 1
+	case has('w'):
+		if f['w'] > 6 {
+			return DateTime{}, fmt.Errorf("weekday out of range")
+		}
+		weekday = (f['w'] + 6) % 7
+	case has('a'):
+		weekday = (f['a'] + 6) % 7
+	default:
+		hasWeekday = false
+	}
+
+	var d Date
+	switch {
+	case hasYear && has('m', 'd') || hasYear && has('m', 'e'):
+		day, ok := f['d']
+		if !ok {
+			day = f['e']
+		}
+		if !isValidDate(year, f['m'], day) {
+			return DateTime{}, fmt.Errorf("day out of range")
+		}
+		d = DateFor(year, time.Month(f['m']), day)
+	case hasYear && has('j'):
+		if f['j'] < 1 || f['j'] > DateFor(year, 12, 31).YearDay() {
+			return DateTime{}, fmt.Errorf("day of year out of range")
+		}
+		d = DateFor(year, 1, f['j'])
+	case hasISOYear && has('V') && hasWeekday:
+		jan4 := DateFor(isoYear, 1, 4)
+		week1 := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7)
+		d = week1.AddDate(0, 0, 7*(f['V']-1)+weekday)
+		if y, w := d.ISOWeek(); y != isoYear || w != f['V'] {
+			return DateTime{}, fmt.Errorf("week out of range")
+		}
+	case hasYear && has('U') && hasWeekday:
+		jan1 := DateFor(year, 1, 1)
+		firstSunday := jan1.AddDate(0, 0, (7-int(jan1.Weekday()))%7)
+		d = firstSunday.AddDate(0, 0, 7*(f['U']-1)+(weekday+1)%7)
+		if f['U'] > 53 || d.Year() != year {
+			return DateTime{}, fmt.Errorf("week out of range")
+		}
+	case hasYear && has('W') && hasWeekday:
+		jan1 := DateFor(year, 1, 1)
+		firstMonday := jan1.AddDate(0, 0, (8-int(jan1.Weekday()))%7)
+		d = firstMonday.AddDate(0, 0, 7*(f['W']-1)+weekday)
+		if f['W'] > 53 || d.Year() != year {
+			return DateTime{}, fmt.Errorf("week out of range")
+		}
+	default:
+		return DateTime{}, fmt.Errorf("insufficient date fields")
+	}
+	if hasWeekday && (int(d.Weekday())+6)%7 != weekday {
+		return DateTime{}, fmt.Errorf("weekday does not match date")
+	}
+	if a, ok := f['a']; ok && time.Weekday(a) != d.Weekday() {
+		return DateTime{}, fmt.Errorf("weekday does not match date")
+	}
+
+	hour, ok := f['H']
+	if !ok {
+		hour = f['k']
+	}
+	if h12, ok := f['I']; ok || has('l') {
+		if !ok {
+			h12 = f['l']
+		}
+		if h12 < 1 || h12 > 12 {
+			return DateTime{}, fmt.Errorf("hour out of range")
+		}
+		hour = h12 % 12
+		if p.pm == 2 {
+			hour += 12
+		}
+	}
+	if !isValidClock(hour, f['M'], f['S']) {
+		return DateTime{}, fmt.Errorf("time out of range")
+	}
+
+	year, month, day := d.Date()
+	dt := DateTimeForNano(year, month, day, hour, f['M'], f['S'], f['f'])
+	return dt.Truncate(DateTimePrecision), nil
+}
@@ -0,0 +1,121 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrftime(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		DateTime DateTime
+		Format   string
+		Expected string
+	}{
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), "%Y-%m-%d %H:%M:%S", "2025-09-30 15:04:05"},
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), "%F %T", "2025-09-30 15:04:05"},
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), "%D %R", "09/30/25 15:04"},
+		{DateTimeFor(2025, 9, 30, 15, 4, 5), "%a %A %b %h %B", "Tue Tuesday Sep Sep September"},
+		{DateTimeFor(2025, 9, 3, 15, 4, 5), "%e|%k|%l|%I %p", " 3|15| 3|03 PM"},
+		{DateTimeFor(2025, 9, 3, 0, 4, 5), "%I:%M %p", "12:04 AM"},
+		{DateTimeForNano(2025, 9, 3, 0, 4, 5, 123456789), "%S.%f", "05.123456"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "%j %u %w %C %y", "273 2 2 20 25"},
+		{DateTimeFor(2025, 1, 1, 0, 0, 0), "%U %W %V %G %g", "00 00 01 2025 25"},
+		{DateTimeFor(2023, 1, 1, 0, 0, 0), "%U %W %V %G %g", "01 00 52 2022 22"},
+		{DateTimeFor(2024, 12, 30, 0, 0, 0), "%U %W %V %G", "52 53 01 2025"},
+		{DateTimeFor(-44, 3, 15, 0, 0, 0), "%Y %C %y", "-0044 -1 56"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "100%% %Q%n%t", "100% %Q\n\t"},
+		{DateTimeFor(2025, 9, 30, 0, 0, 0), "trailing %", "trailing %"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Expected, tc.DateTime.Strftime(tc.Format), tc.Format)
+		year, month, day := tc.DateTime.Date()
+		if tc.DateTime.Equal(DateTimeFor(year, month, day, 0, 0, 0)) {
+			assert.Equal(tc.Expected, DateFor(year, month, day).Strftime(tc.Format), tc.Format)
+		}
+	}
+}
+
+func TestDateStrptime(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Format   string
+		Value    string
+		Expected Date
+		Error    bool
+	}{
+		{Format: "%Y-%m-%d", Value: "2025-09-30", Expected: DateFor(2025, 9, 30)},
+		{Format: "%F", Value: "2025-9-3", Expected: DateFor(2025, 9, 3)},
+		{Format: "%d/%m/%y", Value: "30/09/25", Expected: DateFor(2025, 9, 30)},
+		{Format: "%d/%m/%y", Value: "30/09/69", Expected: DateFor(1969, 9, 30)},
+		{Format: "%D", Value: "09/30/68", Expected: DateFor(2068, 9, 30)},
+		{Format: "%a, %e %b %Y", Value: "Wed,  3 Sep 2025", Expected: DateFor(2025, 9, 3)},
+		{Format: "%A %d %B %Y", Value: "tuesday 30 SEPTEMBER 2025", Expected: DateFor(2025, 9, 30)},
+		{Format: "%Y%j", Value: "2025273", Expected: DateFor(2025, 9, 30)},
+		{Format: "%G-W%V-%u", Value: "2025-W01-1", Expected: DateFor(2024, 12, 30)},
+		{Format: "%G-W%V-%u", Value: "2020-W53-7", Expected: DateFor(2021, 1, 3)},
+		{Format: "%Y %U %w", Value: "2023 01 0", Expected: DateFor(2023, 1, 1)},
+		{Format: "%Y %W %u", Value: "2023 00 7", Expected: DateFor(2023, 1, 1)},
+		{Format: "%Y %W %u", Value: "2023 01 1", Expected: DateFor(2023, 1, 2)},
+		{Format: "%Y %U %a", Value: "2023 01 Sun", Expected: DateFor(2023, 1, 1)},
+		{Format: "%Y-%m-%d %H:%M", Value: "2025-09-30 11:47", Expected: DateFor(2025, 9, 30)},
+		{Format: "%Y-%m-%d", Value: "-0044-03-15", Expected: DateFor(-44, 3, 15)},
+		{Format: "%Y-%m-%d", Value: "2025-02-29", Error: true},
+		{Format: "%Y-%m-%d", Value: "2025-13-01", Error: true},
+		{Format: "%Y-%m-%d", Value: "2025-09-30x", Error: true},
+		{Format: "%Y-%m-%d", Value: "2025/09/30", Error: true},
+		{Format: "%Y-%m", Value: "2025-09", Error: true},
+		{Format: "%Y%j", Value: "2025366", Error: true},
+		{Format: "%G-W%V-%u", Value: "2025-W53-1", Error: true},
+		{Format: "%Y-%m-%d %Q", Value: "2025-09-30 Q", Error: true},
+		{Format: "%a %Y-%m-%d", Value: "Mon 2025-09-30", Error: true},
+		{Format: "%Y-%m-%d %u", Value: "2025-09-30 1", Error: true},
+		{Format: "%Y %U %w", Value: "2023 54 0", Error: true},
+		{Format: "%Y %U %w", Value: "2023 00 0", Error: true},
+		{Format: "%Y %W %u", Value: "2023 53 2", Error: true},
+	}
+
+	for _, tc := range testCases {
+		d, err := DateStrptime(tc.Format, tc.Value)
+		if tc.Error {
+			assert.Error(err, tc.Value)
+		} else {
+			assert.NoError(err, tc.Value)
+			assert.Equal(tc.Expected, d, tc.Value+": "+datesNotEqual(tc.Expected, d))
+		}
+	}
+}
+
+func TestDateTimeStrptime(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Format   string
+		Value    string
+		Expected DateTime
+		Error    bool
+	}{
+		{Format: "%Y-%m-%d %H:%M:%S", Value: "2025-09-30 15:04:05", Expected: DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{Format: "%F %T", Value: "2025-09-30 15:04:05", Expected: DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{Format: "%F%t%R", Value: "2025-09-30    15:04", Expected: DateTimeFor(2025, 9, 30, 15, 4, 0)},
+		{Format: "%b %d %Y %I:%M %p", Value: "Sep 30 2025 03:04 pm", Expected: DateTimeFor(2025, 9, 30, 15, 4, 0)},
+		{Format: "%b %d %Y %l:%M %p", Value: "Sep 30 2025 12:04 AM", Expected: DateTimeFor(2025, 9, 30, 0, 4, 0)},
+		{Format: "%b %d %Y %I:%M %p", Value: "Sep 30 2025 12:04 PM", Expected: DateTimeFor(2025, 9, 30, 12, 4, 0)},
+		{Format: "%Y-%m-%dT%H:%M:%S.%f", Value: "2025-09-30T15:04:05.999", Expected: DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{Format: "100%% %Y-%m-%d", Value: "100% 2025-09-30", Expected: DateTimeFor(2025, 9, 30, 0, 0, 0)},
+		{Format: "%Y-%m-%d %H:%M", Value: "2025-09-30 24:00", Error: true},
+		{Format: "%Y-%m-%d %I:%M %p", Value: "2025-09-30 13:00 PM", Error: true},
+		{Format: "%H:%M", Value: "11:47", Error: true},
+	}
+
+	for _, tc := range testCases {
+		dt, err := DateTimeStrptime(tc.Format, tc.Value)
+		if tc.Error {
+			assert.Error(err, tc.Value)
+		} else {
+			assert.NoError(err, tc.Value)
+			assert.Equal(tc.Expected, dt, tc.Value+": "+dateTimesNotEqual(tc.Expected, dt))
+		}
+	}
+}
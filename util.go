@@ -0,0 +1,63 @@
+package local
+
+import "strings"
+
+// floorDiv returns a divided by b, rounded towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// floorDiv64 is the int64 equivalent of floorDiv.
+func floorDiv64(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns the remainder of floorDiv(a, b), which has the same sign as b.
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
+
+// matchName returns the length of the longest name in lists that is a
+// prefix of value, ignoring case, along with the index of the name in its list.
+func matchName(value string, lists ...[]string) (n int, index int) {
+	for _, names := range lists {
+		for i, name := range names {
+			if len(name) > n && len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+				n, index = len(name), i
+			}
+		}
+	}
+	return n, index
+}
+
+// countDigits returns the number of consecutive ASCII digits in s
+// starting at pos, up to a maximum of max.
+func countDigits(s string, pos int, max int) int {
+	n := 0
+	for pos+n < len(s) && n < max && s[pos+n] >= '0' && s[pos+n] <= '9' {
+		n++
+	}
+	return n
+}
+
+// isSpace reports whether c is an ASCII white space character.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// skipSpace returns the position of the first character in s at
+// or after pos that is not white space.
+func skipSpace(s string, pos int) int {
+	for pos < len(s) && isSpace(s[pos]) {
+		pos++
+	}
+	return pos
+}
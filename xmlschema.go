@@ -0,0 +1,238 @@
+package local
+
+import (
+	"encoding/xml"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errInvalidXMLDate     = errors.New("invalid xs:date")
+	errInvalidXMLDateTime = errors.New("invalid xs:dateTime")
+)
+
+// StrictXML controls how Date and DateTime values are decoded from XML
+// elements and attributes.
+//
+// When StrictXML is false (the default), UnmarshalXML and UnmarshalXMLAttr
+// accept the XML Schema lexical forms, and any of the formats recognized
+// by DateParse and DateTimeParse.
+//
+// When StrictXML is true, Date only accepts the lexical form of xs:date and
+// DateTime only accepts the lexical form of xs:dateTime, as described for
+// DateParseXMLSchema and DateTimeParseXMLSchema.
+//
+// StrictXML is intended to be set once during program initialization.
+var StrictXML bool
+
+var xmlSchemaRegexp = struct {
+	date     *regexp.Regexp
+	dateTime *regexp.Regexp
+}{
+	date:     regexp.MustCompile(`^(-?\d{4,})-(\d{2})-(\d{2})(Z|[+-]\d{2}:\d{2})?$`),
+	dateTime: regexp.MustCompile(`^(-?\d{4,})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})(\.\d+)?(Z|[+-]\d{2}:\d{2})?$`),
+}
+
+// DateParseXMLSchema parses the lexical form of an XML Schema xs:date
+// (yyyy-mm-dd), which may be followed by a timezone (Z or ±hh:mm).
+// The year has at least four digits, may be negative, and may only have
+// a leading zero if it has four digits. Leading and trailing white space
+// is ignored.
+//
+// The date is returned exactly as written, along with a fixed location that
+// describes the timezone. If s does not contain a timezone, the returned
+// location is nil.
+func DateParseXMLSchema(s string) (Date, *time.Location, error) {
+	match := xmlSchemaRegexp.date.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return Date{}, nil, errInvalidXMLDate
+	}
+	year, ok := parseXMLSchemaYear(match[1])
+	month, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	if !ok || !isValidDate(year, month, day) {
+		return Date{}, nil, errInvalidXMLDate
+	}
+	loc, ok := parseXMLSchemaZone(match[4])
+	if !ok {
+		return Date{}, nil, errInvalidXMLDate
+	}
+	return DateFor(year, time.Month(month), day), loc, nil
+}
+
+// DateTimeParseXMLSchema parses the lexical form of an XML Schema xs:dateTime
+// (yyyy-mm-ddThh:mm:ss, with optional fractional seconds), which may be followed
+// by a timezone (Z or ±hh:mm). The year follows the same rules as for
+// DateParseXMLSchema. A time of 24:00:00 is the first instant of the
+// following day. Leading and trailing white space is ignored, and fractional
+// seconds are kept to DateTimePrecision.
+//
+// The date-time is returned exactly as written, along with a fixed location that
+// describes the timezone. If s does not contain a timezone, the returned
+// location is nil.
+func DateTimeParseXMLSchema(s string) (DateTime, *time.Location, error) {
+	match := xmlSchemaRegexp.dateTime.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return DateTime{}, nil, errInvalidXMLDateTime
+	}
+	year, ok := parseXMLSchemaYear(match[1])
+	month, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	hour, _ := strconv.Atoi(match[4])
+	minute, _ := strconv.Atoi(match[5])
+	second, _ := strconv.Atoi(match[6])
+	nanosecond := parseFraction(match[7])
+	endOfDay := hour == 24 && minute == 0 && second == 0 && strings.Trim(match[7], ".0") == ""
+	if !ok || !isValidDate(year, month, day) || !isValidClock(hour, minute, second) && !endOfDay {
+		return DateTime{}, nil, errInvalidXMLDateTime
+	}
+	loc, ok := parseXMLSchemaZone(match[8])
+	if !ok {
+		return DateTime{}, nil, errInvalidXMLDateTime
+	}
+	dt := DateTimeForNano(year, time.Month(month), day, hour, minute, second, nanosecond)
+	return dt.Truncate(DateTimePrecision), loc, nil
+}
+
+// parseXMLSchemaYear parses the year of an XML Schema date. Years with
+// more than four digits cannot have a leading zero.
+func parseXMLSchemaYear(s string) (int, bool) {
+	digits := strings.TrimPrefix(s, "-")
+	if len(digits) > 4 && digits[0] == '0' {
+		return 0, false
+	}
+	year, err := strconv.Atoi(s)
+	return year, err == nil
+}
+
+// parseXMLSchemaZone returns a fixed location for an XML Schema timezone,
+// which is in the range -14:00 to +14:00. An empty string returns nil.
+func parseXMLSchemaZone(zone string) (*time.Location, bool) {
+	if zone == "" {
+		return nil, true
+	}
+	if zone == "Z" {
+		return time.UTC, true
+	}
+	hours, _ := strconv.Atoi(zone[1:3])
+	minutes, _ := strconv.Atoi(zone[4:6])
+	if minutes > 59 || hours > 14 || hours == 14 && minutes > 0 {
+		return nil, false
+	}
+	offset := hours*3600 + minutes*60
+	if zone[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone("", offset), true
+}
+
+// dateParseXML parses the text of an XML element or attribute
+// according to StrictXML. Any timezone is ignored.
+func dateParseXML(s string) (Date, error) {
+	d, _, err := DateParseXMLSchema(s)
+	if err != nil && !StrictXML {
+		if d, err = DateParse(s); err != nil {
+			err = errInvalidXMLDate
+		}
+	}
+	return d, err
+}
+
+// dateTimeParseXML parses the text of an XML element or attribute
+// according to StrictXML. Any timezone is ignored.
+func dateTimeParseXML(s string) (DateTime, error) {
+	dt, _, err := DateTimeParseXMLSchema(s)
+	if err != nil && !StrictXML {
+		if dt, err = DateTimeParse(s); err != nil {
+			err = errInvalidXMLDateTime
+		}
+	}
+	return dt, err
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// The date is in the lexical form of xs:date (yyyy-mm-dd)
+// without a timezone.
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(d.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// The element is expected to contain an xs:date, or if StrictXML is not
+// set, a date in one of the formats recognized by DateParse. Any timezone
+// is ignored, and the date is kept as written.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	d1, err := dateParseXML(s)
+	if err != nil {
+		return err
+	}
+	*d = d1
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// The date is in the lexical form of xs:date (yyyy-mm-dd)
+// without a timezone.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: d.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// The attribute value is parsed in the same way as for UnmarshalXML.
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	d1, err := dateParseXML(attr.Value)
+	if err != nil {
+		return err
+	}
+	*d = d1
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// The date-time is in the lexical form of xs:dateTime
+// (yyyy-mm-ddThh:mm:ss) without a timezone.
+func (dt DateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(dt.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// The element is expected to contain an xs:dateTime, or if StrictXML is not
+// set, a date-time in one of the formats recognized by DateTimeParse. Any
+// timezone is ignored, and the date-time is kept as written.
+func (dt *DateTime) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	dt1, err := dateTimeParseXML(s)
+	if err != nil {
+		return err
+	}
+	*dt = dt1
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// The date-time is in the lexical form of xs:dateTime
+// (yyyy-mm-ddThh:mm:ss) without a timezone.
+func (dt DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: dt.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// The attribute value is parsed in the same way as for UnmarshalXML.
+func (dt *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	dt1, err := dateTimeParseXML(attr.Value)
+	if err != nil {
+		return err
+	}
+	*dt = dt1
+	return nil
+}
@@ -0,0 +1,171 @@
+package local
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateParseXMLSchema(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text   string
+		Date   Date
+		Offset int
+		Zone   bool
+		Error  bool
+	}{
+		{Text: "2025-09-30", Date: DateFor(2025, 9, 30)},
+		{Text: " 2025-09-30\n", Date: DateFor(2025, 9, 30)},
+		{Text: "2025-09-30Z", Date: DateFor(2025, 9, 30), Zone: true},
+		{Text: "2025-09-30+10:00", Date: DateFor(2025, 9, 30), Offset: 36000, Zone: true},
+		{Text: "2025-09-30-14:00", Date: DateFor(2025, 9, 30), Offset: -50400, Zone: true},
+		{Text: "-0044-03-15", Date: DateFor(-44, 3, 15)},
+		{Text: "12025-01-01", Date: DateFor(12025, 1, 1)},
+		{Text: "0001-01-01", Date: Date{}},
+		{Text: "2024-02-29", Date: DateFor(2024, 2, 29)},
+		{Text: "2025-02-29", Error: true},
+		{Text: "2025-13-01", Error: true},
+		{Text: "02025-01-01", Error: true},
+		{Text: "25-01-01", Error: true},
+		{Text: "2025-09-30+14:30", Error: true},
+		{Text: "2025-09-30+1000", Error: true},
+		{Text: "2025-09-30z", Error: true},
+		{Text: "20250930", Error: true},
+		{Text: "2025-09-30T00:00:00", Error: true},
+	}
+	for _, tc := range testCases {
+		d, loc, err := DateParseXMLSchema(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+			continue
+		}
+		if !assert.NoError(err, tc.Text) {
+			continue
+		}
+		assert.Equal(tc.Date, d, tc.Text)
+		if tc.Zone {
+			if assert.NotNil(loc, tc.Text) {
+				_, offset := time.Date(2025, 1, 1, 0, 0, 0, 0, loc).Zone()
+				assert.Equal(tc.Offset, offset, tc.Text)
+			}
+		} else {
+			assert.Nil(loc, tc.Text)
+		}
+	}
+}
+
+func TestDateTimeParseXMLSchema(t *testing.T) {
+	assert := assert.New(t)
+	defer func(p time.Duration) { DateTimePrecision = p }(DateTimePrecision)
+	DateTimePrecision = time.Millisecond
+
+	testCases := []struct {
+		Text     string
+		DateTime DateTime
+		Zone     bool
+		Error    bool
+	}{
+		{Text: "2025-09-30T15:04:05", DateTime: DateTimeFor(2025, 9, 30, 15, 4, 5)},
+		{Text: "2025-09-30T15:04:05.123456", DateTime: DateTimeForNano(2025, 9, 30, 15, 4, 5, 123000000)},
+		{Text: "2025-09-30T15:04:05Z", DateTime: DateTimeFor(2025, 9, 30, 15, 4, 5), Zone: true},
+		{Text: "2025-09-30T15:04:05-05:00", DateTime: DateTimeFor(2025, 9, 30, 15, 4, 5), Zone: true},
+		{Text: "2025-09-30T24:00:00", DateTime: DateTimeFor(2025, 10, 1, 0, 0, 0)},
+		{Text: "2025-12-31T24:00:00.000", DateTime: DateTimeFor(2026, 1, 1, 0, 0, 0)},
+		{Text: "-0001-12-31T23:59:59", DateTime: DateTimeFor(-1, 12, 31, 23, 59, 59)},
+		{Text: "2025-09-30T24:00:01", Error: true},
+		{Text: "2025-09-30T24:00:00.5", Error: true},
+		{Text: "2025-09-30T15:60:00", Error: true},
+		{Text: "2025-09-30t15:04:05", Error: true},
+		{Text: "2025-09-30T15:04", Error: true},
+		{Text: "2025-09-30 15:04:05", Error: true},
+		{Text: "2025-09-31T15:04:05", Error: true},
+	}
+	for _, tc := range testCases {
+		dt, loc, err := DateTimeParseXMLSchema(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+			continue
+		}
+		if !assert.NoError(err, tc.Text) {
+			continue
+		}
+		assert.Equal(tc.DateTime, dt, tc.Text)
+		assert.Equal(tc.Zone, loc != nil, tc.Text)
+	}
+}
+
+func TestXMLSchemaMarshal(t *testing.T) {
+	assert := assert.New(t)
+	defer func(p time.Duration) { DateTimePrecision = p }(DateTimePrecision)
+	DateTimePrecision = time.Millisecond
+
+	type testStruct struct {
+		XMLName  xml.Name       `xml:"event"`
+		Date     Date           `xml:"date,attr"`
+		Starts   DateTime       `xml:"starts,attr"`
+		Founded  Date           `xml:"founded"`
+		Updated  DateTime       `xml:"updated"`
+		Reviewed Null[Date]     `xml:"reviewed,attr"`
+		Closed   Null[DateTime] `xml:"closed"`
+	}
+	v := testStruct{
+		Date:     DateFor(-44, 3, 15),
+		Starts:   DateTimeFor(2025, 9, 30, 9, 0, 0),
+		Founded:  DateFor(12025, 1, 1),
+		Updated:  DateTimeForNano(2025, 9, 30, 15, 4, 5, 500000000),
+		Reviewed: NullFor(DateFor(2025, 10, 1)),
+	}
+	data, err := xml.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`<event date="-0044-03-15" starts="2025-09-30T09:00:00" reviewed="2025-10-01">`+
+		`<founded>12025-01-01</founded><updated>2025-09-30T15:04:05.5</updated></event>`, string(data))
+
+	var v2 testStruct
+	assert.NoError(xml.Unmarshal(data, &v2))
+	v2.XMLName = xml.Name{}
+	assert.Equal(v, v2)
+
+	input := `<event date="2025-09-30+10:00" starts="2025-09-30T09:00:00Z" reviewed="2025-10-01Z">` +
+		`<founded> 1901-01-01 </founded><updated>2025-09-30T15:04:05-05:00</updated><closed>2025-10-02T24:00:00</closed></event>`
+	assert.NoError(xml.Unmarshal([]byte(input), &v2))
+	assert.Equal(DateFor(2025, 9, 30), v2.Date)
+	assert.Equal(DateTimeFor(2025, 9, 30, 9, 0, 0), v2.Starts)
+	assert.Equal(NullFor(DateFor(2025, 10, 1)), v2.Reviewed)
+	assert.Equal(DateFor(1901, 1, 1), v2.Founded)
+	assert.Equal(DateTimeFor(2025, 9, 30, 15, 4, 5), v2.Updated)
+	assert.Equal(NullFor(DateTimeFor(2025, 10, 3, 0, 0, 0)), v2.Closed)
+}
+
+func TestStrictXML(t *testing.T) {
+	assert := assert.New(t)
+	defer func(strict bool) { StrictXML = strict }(StrictXML)
+
+	type testStruct struct {
+		Date     Date       `xml:"date,attr"`
+		DateTime DateTime   `xml:"dateTime"`
+		Null     Null[Date] `xml:"null"`
+	}
+	lenient := []string{
+		`<test date="20250930"/>`,
+		`<test><dateTime>2025-09-30 15:04</dateTime></test>`,
+		`<test><null>2025/09/30</null></test>`,
+	}
+	for _, input := range lenient {
+		var v testStruct
+		StrictXML = false
+		assert.NoError(xml.Unmarshal([]byte(input), &v), input)
+		StrictXML = true
+		assert.Error(xml.Unmarshal([]byte(input), &v), input)
+	}
+
+	StrictXML = true
+	var v testStruct
+	assert.NoError(xml.Unmarshal([]byte(`<test date="2025-09-30Z"><dateTime>2025-09-30T15:04:05</dateTime></test>`), &v))
+	assert.Equal(DateFor(2025, 9, 30), v.Date)
+	assert.Equal(DateTimeFor(2025, 9, 30, 15, 4, 5), v.DateTime)
+	err := xml.Unmarshal([]byte(`<test><dateTime>2025-09-30T15:04:05+10</dateTime></test>`), &v)
+	assert.EqualError(err, "invalid xs:dateTime")
+}
@@ -0,0 +1,45 @@
+package local
+
+import (
+	"time"
+)
+
+// Year represents a year in the proleptic Gregorian calendar. Year 0
+// is 1 BC, year -1 is 2 BC, and so on.
+type Year int
+
+// IsLeap reports whether y is a leap year.
+func (y Year) IsLeap() bool {
+	return y%4 == 0 && (y%100 != 0 || y%400 == 0)
+}
+
+// Days returns the number of days in the year, which is 365 or 366.
+func (y Year) Days() int {
+	if y.IsLeap() {
+		return 366
+	}
+	return 365
+}
+
+// FirstDate returns January 1 of the year.
+func (y Year) FirstDate() Date {
+	return DateFor(int(y), time.January, 1)
+}
+
+// LastDate returns December 31 of the year.
+func (y Year) LastDate() Date {
+	return DateFor(int(y), time.December, 31)
+}
+
+// Contains reports whether d occurs in the year.
+func (y Year) Contains(d Date) bool {
+	return d.Year() == int(y)
+}
+
+// ISOWeeks returns the number of weeks in the ISO 8601 week-based year,
+// which is 52 or 53.
+func (y Year) ISOWeeks() int {
+	// December 28 is always in the last week of the week-based year
+	_, week := DateFor(int(y), time.December, 28).ISOWeek()
+	return week
+}
@@ -0,0 +1,36 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYear(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Year     Year
+		IsLeap   bool
+		Days     int
+		ISOWeeks int
+	}{
+		{2024, true, 366, 52},
+		{2025, false, 365, 52},
+		{2026, false, 365, 53},
+		{2020, true, 366, 53},
+		{1900, false, 365, 52},
+		{2000, true, 366, 52},
+		{0, true, 366, 52},
+		{-4, true, 366, 52},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.IsLeap, tc.Year.IsLeap(), "%d", tc.Year)
+		assert.Equal(tc.Days, tc.Year.Days(), "%d", tc.Year)
+		assert.Equal(tc.ISOWeeks, tc.Year.ISOWeeks(), "%d", tc.Year)
+		assert.Equal(tc.Days, tc.Year.LastDate().YearDay(), "%d", tc.Year)
+		assert.True(DateFor(int(tc.Year), 1, 1).Equal(tc.Year.FirstDate()), "%d", tc.Year)
+		assert.True(tc.Year.Contains(tc.Year.LastDate()), "%d", tc.Year)
+		assert.False(tc.Year.Contains(tc.Year.LastDate().AddDate(0, 0, 1)), "%d", tc.Year)
+	}
+}
@@ -0,0 +1,260 @@
+package local
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidYearMonthFormat = errors.New("invalid year-month format")
+
+// YearMonth represents a month in a particular year, without a day,
+// a time or a timezone. Useful for representing card expiry dates,
+// billing periods and monthly reports, for example.
+//
+// The zero value of YearMonth is January of year 1.
+type YearMonth struct {
+	months int // number of months since January of year 1
+}
+
+// YearMonthFor returns the year-month for the given year and month.
+// The month may be outside its usual range and will be normalized,
+// so that month 13 is January of the following year.
+func YearMonthFor(year int, month time.Month) YearMonth {
+	return YearMonth{months: (year-1)*12 + int(month) - 1}
+}
+
+// YearMonthFromDate returns the year-month in which d occurs.
+func YearMonthFromDate(d Date) YearMonth {
+	return YearMonthFor(d.Year(), d.Month())
+}
+
+// Year returns the year of ym.
+func (ym YearMonth) Year() int {
+	return floorDiv(ym.months, 12) + 1
+}
+
+// Month returns the month of the year of ym.
+func (ym YearMonth) Month() time.Month {
+	return time.Month(floorMod(ym.months, 12) + 1)
+}
+
+// After reports whether ym is after e.
+func (ym YearMonth) After(e YearMonth) bool {
+	return ym.months > e.months
+}
+
+// Before reports whether ym is before e.
+func (ym YearMonth) Before(e YearMonth) bool {
+	return ym.months < e.months
+}
+
+// Equal reports whether ym and e represent the same year-month.
+func (ym YearMonth) Equal(e YearMonth) bool {
+	return ym.months == e.months
+}
+
+// IsZero reports whether ym represents the zero year-month,
+// January of year 1.
+func (ym YearMonth) IsZero() bool {
+	return ym.months == 0
+}
+
+// AddMonths returns the year-month that is the given number of
+// months after ym. The number of months may be negative.
+func (ym YearMonth) AddMonths(months int) YearMonth {
+	return YearMonth{months: ym.months + months}
+}
+
+// Sub returns the number of months from e to ym.
+func (ym YearMonth) Sub(e YearMonth) int {
+	return ym.months - e.months
+}
+
+// Days returns the number of days in the month.
+func (ym YearMonth) Days() int {
+	return daysIn(ym.Month(), ym.Year())
+}
+
+// FirstDate returns the first day of the month.
+func (ym YearMonth) FirstDate() Date {
+	return DateFor(ym.Year(), ym.Month(), 1)
+}
+
+// LastDate returns the last day of the month.
+func (ym YearMonth) LastDate() Date {
+	return DateFor(ym.Year(), ym.Month(), ym.Days())
+}
+
+// Contains reports whether d occurs in the month.
+func (ym YearMonth) Contains(d Date) bool {
+	return YearMonthFromDate(d).Equal(ym)
+}
+
+// Dates returns each of the days in the month, in order.
+func (ym YearMonth) Dates() []Date {
+	dates := make([]Date, ym.Days())
+	year, month := ym.Year(), ym.Month()
+	for i := range dates {
+		dates[i] = DateFor(year, month, i+1)
+	}
+	return dates
+}
+
+// Format returns a textual representation of the year-month formatted
+// according to layout, which takes the same form as the standard library
+// time package. Day fields in the layout are formatted as the first
+// day of the month.
+func (ym YearMonth) Format(layout string) string {
+	return ym.FirstDate().Format(layout)
+}
+
+// String returns a string representation of ym. The format
+// returned is compatible with ISO 8601: yyyy-mm.
+func (ym YearMonth) String() string {
+	year := ym.Year()
+	sign := ""
+	if year < 0 {
+		year = -year
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%04d-%02d", sign, year, int(ym.Month()))
+}
+
+var yearMonthRegexp = struct {
+	lenient *regexp.Regexp
+	strict  *regexp.Regexp
+}{
+	lenient: regexp.MustCompile(`^(-?\d{4})[-/.](\d{1,2})$`),
+	strict:  regexp.MustCompile(`^(-?\d{4})-(\d{2})$`),
+}
+
+// YearMonthParse parses a string and returns the year-month it represents.
+// Leading and trailing space and quotation marks are ignored. The following
+// formats are recognized: yyyy-mm, yyyy/mm and yyyy.mm.
+func YearMonthParse(s string) (YearMonth, error) {
+	return parseYearMonth(strings.Trim(s, " \t\"'"), yearMonthRegexp.lenient)
+}
+
+func parseYearMonth(s string, re *regexp.Regexp) (YearMonth, error) {
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return YearMonth{}, errInvalidYearMonthFormat
+	}
+
+	// no error checking here because matching the regexp
+	// guarantees that parsing the strings will succeed.
+	year, _ := strconv.Atoi(match[1])
+	month, _ := strconv.Atoi(match[2])
+	if month < 1 || month > 12 {
+		return YearMonth{}, errInvalidYearMonthFormat
+	}
+	return YearMonthFor(year, time.Month(month)), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is five bytes: the year as a big-endian int32, followed
+// by the month.
+func (ym YearMonth) MarshalBinary() ([]byte, error) {
+	year := ym.Year()
+	if year < math.MinInt32 || year > math.MaxInt32 {
+		return nil, errors.New("local.YearMonth.MarshalBinary: year out of range")
+	}
+	data := make([]byte, 5)
+	binary.BigEndian.PutUint32(data, uint32(int32(year)))
+	data[4] = byte(ym.Month())
+	return data, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (ym *YearMonth) UnmarshalBinary(data []byte) error {
+	if len(data) != 5 || data[4] < 1 || data[4] > 12 {
+		return errors.New("local.YearMonth.UnmarshalBinary: invalid data")
+	}
+	year := int(int32(binary.BigEndian.Uint32(data)))
+	*ym = YearMonthFor(year, time.Month(data[4]))
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The year-month is a quoted string in an ISO 8601 format (yyyy-mm).
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + ym.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The year-month is expected to be a quoted string in one of the formats
+// recognized by YearMonthParse. If StrictJSON is set, the year-month
+// must be a quoted string in the format yyyy-mm.
+func (ym *YearMonth) UnmarshalJSON(data []byte) (err error) {
+	if StrictJSON {
+		s, ok := unquoteJSON(data)
+		if !ok {
+			return errInvalidYearMonthFormat
+		}
+		*ym, err = parseYearMonth(s, yearMonthRegexp.strict)
+		return
+	}
+	*ym, err = YearMonthParse(string(data))
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The format is yyyy-mm.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The year-month is expected to be in one of the formats recognized
+// by YearMonthParse.
+func (ym *YearMonth) UnmarshalText(data []byte) (err error) {
+	*ym, err = YearMonthParse(string(data))
+	return
+}
+
+// Scan implements the sql.Scanner interface. A string value may be a
+// year-month in one of the formats recognized by YearMonthParse, or a date
+// in one of the formats recognized by DateParse. A time.Time value is
+// converted to the year-month of its date.
+func (ym *YearMonth) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return ym.scanString(v)
+	case []byte:
+		return ym.scanString(string(v))
+	case time.Time:
+		*ym = YearMonthFromDate(DateFromTime(v))
+	case nil:
+		*ym = YearMonth{}
+	default:
+		return errors.New("cannot convert to local.YearMonth")
+	}
+	return nil
+}
+
+func (ym *YearMonth) scanString(s string) error {
+	if ym1, err := YearMonthParse(s); err == nil {
+		*ym = ym1
+		return nil
+	}
+	d, err := DateParse(s)
+	if err != nil {
+		return errInvalidYearMonthFormat
+	}
+	*ym = YearMonthFromDate(d)
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The value is the
+// first day of the month at midnight UTC, so that year-months can be
+// stored in DATE columns.
+func (ym YearMonth) Value() (driver.Value, error) {
+	return ym.FirstDate().Value()
+}
@@ -0,0 +1,174 @@
+package local
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearMonthFor(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Year          int
+		Month         time.Month
+		ExpectedYear  int
+		ExpectedMonth time.Month
+		String        string
+	}{
+		{2025, 9, 2025, 9, "2025-09"},
+		{2025, 13, 2026, 1, "2026-01"},
+		{2025, 0, 2024, 12, "2024-12"},
+		{1, 1, 1, 1, "0001-01"},
+		{0, 12, 0, 12, "0000-12"},
+		{-44, 3, -44, 3, "-0044-03"},
+	}
+
+	for _, tc := range testCases {
+		ym := YearMonthFor(tc.Year, tc.Month)
+		assert.Equal(tc.ExpectedYear, ym.Year(), tc.String)
+		assert.Equal(tc.ExpectedMonth, ym.Month(), tc.String)
+		assert.Equal(tc.String, ym.String())
+	}
+	assert.True(YearMonth{}.Equal(YearMonthFor(1, 1)))
+	assert.True(YearMonth{}.IsZero())
+	assert.True(YearMonthFor(2025, 9).Equal(YearMonthFromDate(DateFor(2025, 9, 30))))
+}
+
+func TestYearMonthArithmetic(t *testing.T) {
+	assert := assert.New(t)
+	ym := YearMonthFor(2025, 11)
+	assert.Equal("2026-02", ym.AddMonths(3).String())
+	assert.Equal("2024-11", ym.AddMonths(-12).String())
+	assert.Equal(15, YearMonthFor(2027, 2).Sub(ym))
+	assert.Equal(-15, ym.Sub(YearMonthFor(2027, 2)))
+	assert.True(ym.After(YearMonthFor(2025, 10)))
+	assert.True(ym.Before(YearMonthFor(2026, 1)))
+	assert.False(ym.Before(ym))
+}
+
+func TestYearMonthDates(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		YearMonth YearMonth
+		Days      int
+		First     Date
+		Last      Date
+	}{
+		{YearMonthFor(2024, 2), 29, DateFor(2024, 2, 1), DateFor(2024, 2, 29)},
+		{YearMonthFor(2025, 2), 28, DateFor(2025, 2, 1), DateFor(2025, 2, 28)},
+		{YearMonthFor(2025, 9), 30, DateFor(2025, 9, 1), DateFor(2025, 9, 30)},
+		{YearMonthFor(2025, 12), 31, DateFor(2025, 12, 1), DateFor(2025, 12, 31)},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(tc.Days, tc.YearMonth.Days())
+		assert.True(tc.First.Equal(tc.YearMonth.FirstDate()))
+		assert.True(tc.Last.Equal(tc.YearMonth.LastDate()))
+		dates := tc.YearMonth.Dates()
+		if assert.Len(dates, tc.Days) {
+			assert.True(tc.First.Equal(dates[0]))
+			assert.True(tc.Last.Equal(dates[len(dates)-1]))
+		}
+		assert.True(tc.YearMonth.Contains(tc.Last))
+		assert.False(tc.YearMonth.Contains(tc.Last.AddDate(0, 0, 1)))
+	}
+	assert.Equal("09/25", YearMonthFor(2025, 9).Format("01/06"))
+}
+
+func TestYearMonthParse(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Text     string
+		Error    bool
+		Expected YearMonth
+	}{
+		{Text: "2025-09", Expected: YearMonthFor(2025, 9)},
+		{Text: " \"2025-9\" ", Expected: YearMonthFor(2025, 9)},
+		{Text: "2025/12", Expected: YearMonthFor(2025, 12)},
+		{Text: "-0044-03", Expected: YearMonthFor(-44, 3)},
+		{Text: "2025-13", Error: true},
+		{Text: "2025-00", Error: true},
+		{Text: "2025-09-30", Error: true},
+		{Text: "202509", Error: true},
+	}
+
+	for _, tc := range testCases {
+		ym, err := YearMonthParse(tc.Text)
+		if tc.Error {
+			assert.Error(err, tc.Text)
+		} else if assert.NoError(err, tc.Text) {
+			assert.True(tc.Expected.Equal(ym), tc.Text)
+		}
+	}
+}
+
+func TestYearMonthMarshal(t *testing.T) {
+	assert := assert.New(t)
+	var v struct {
+		Expiry YearMonth `json:"expiry"`
+	}
+	v.Expiry = YearMonthFor(2027, 3)
+	data, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"expiry":"2027-03"}`, string(data))
+
+	v.Expiry = YearMonth{}
+	assert.NoError(json.Unmarshal([]byte(`{"expiry":"2028-4"}`), &v))
+	assert.Equal("2028-04", v.Expiry.String())
+
+	StrictJSON = true
+	defer func() { StrictJSON = false }()
+	assert.Error(json.Unmarshal([]byte(`{"expiry":"2028-4"}`), &v))
+	assert.NoError(json.Unmarshal([]byte(`{"expiry":"2028-05"}`), &v))
+	assert.Equal("2028-05", v.Expiry.String())
+
+	text, err := v.Expiry.MarshalText()
+	assert.NoError(err)
+	assert.Equal("2028-05", string(text))
+	var ym YearMonth
+	assert.NoError(ym.UnmarshalText([]byte("2029-06")))
+	assert.Equal("2029-06", ym.String())
+
+	data, err = ym.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{0, 0, 0x07, 0xed, 6}, data)
+	var ym2 YearMonth
+	assert.NoError(ym2.UnmarshalBinary(data))
+	assert.Equal(ym, ym2)
+	assert.Error(ym2.UnmarshalBinary([]byte{0, 0, 0x07, 0xed, 13}))
+	assert.Error(ym2.UnmarshalBinary(data[:4]))
+}
+
+func TestYearMonthScanValue(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		Value    interface{}
+		Error    bool
+		Expected YearMonth
+	}{
+		{Value: "2056-11", Expected: YearMonthFor(2056, 11)},
+		{Value: "2056-11-01", Expected: YearMonthFor(2056, 11)},
+		{Value: []byte("2157-12"), Expected: YearMonthFor(2157, 12)},
+		{Value: time.Date(2056, 10, 1, 0, 0, 0, 0, time.UTC), Expected: YearMonthFor(2056, 10)},
+		{Value: nil, Expected: YearMonth{}},
+		{Value: []byte("xxx"), Error: true},
+		{Value: int64(11), Error: true},
+	}
+
+	for _, tc := range testCases {
+		var ym YearMonth
+		err := ym.Scan(tc.Value)
+		if tc.Error {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+			assert.True(ym.Equal(tc.Expected))
+		}
+	}
+
+	v, err := YearMonthFor(2071, 1).Value()
+	assert.NoError(err)
+	assert.Equal(time.Date(2071, 1, 1, 0, 0, 0, 0, time.UTC), v)
+}